@@ -1,17 +1,19 @@
 package cmd
 
 import (
+	"context"
 	"field-service/clients"
 	"field-service/common/gcs"
 	"field-service/common/response"
 	"field-service/config"
 	"field-service/constants"
 	"field-service/controllers"
-	"field-service/domain/models"
 	"field-service/middlewares"
+	"field-service/migrations"
 	"field-service/repositories"
 	"field-service/routes"
 	"field-service/services"
+	"field-service/workers"
 	"fmt"
 	"net/http"
 	"time"
@@ -23,7 +25,16 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// command adalah root command; tanpa subcommand tetap menjalankan server seperti serve.
 var command = &cobra.Command{
+	Use:   "field-service",
+	Short: "field service",
+	Run: func(c *cobra.Command, args []string) {
+		serveCommand.Run(c, args)
+	},
+}
+
+var serveCommand = &cobra.Command{
 	Use:   "serve",
 	Short: "start the server",
 	Run: func(c *cobra.Command, args []string) {
@@ -36,22 +47,32 @@ var command = &cobra.Command{
 			panic(err)
 		}
 
-		loc, err := time.LoadLocation("Asia/Jakarta")
+		// 📝 Catatan:
+		// Zona waktu tidak lagi di-set global lewat time.Local. Setiap venue punya timezone sendiri
+		// (WIB/WITA/WIT) dan dipakai saat generate jadwal maupun format response.
+
+		// 📝 Catatan:
+		// Schema tidak lagi dibuat lewat AutoMigrate saat start. Jalankan `field-service migrate up`
+		// sebelum deploy; serve menolak start kalau masih ada migration yang belum dijalankan.
+		migrator, err := migrations.NewMigrator(db)
 		if err != nil {
 			panic(err)
 		}
 
-		time.Local = loc
-
-		err = db.AutoMigrate(
-			&models.Field{},
-			&models.FieldSchedule{},
-			&models.Time{},
-		)
+		pending, err := migrator.Pending(context.Background())
 		if err != nil {
 			panic(err)
 		}
 
+		if len(pending) > 0 {
+			panic(fmt.Sprintf(
+				"schema database tertinggal %d migration (versi terbaru belum dijalankan: %d_%s), jalankan `field-service migrate up` dulu",
+				len(pending),
+				pending[len(pending)-1].Version,
+				pending[len(pending)-1].Name,
+			))
+		}
+
 		gcs := gcs.NewGCSClient(config.Config.GCSCredentialPath, config.Config.GCSBucketName)
 		client := clients.NewClientRegistry()
 
@@ -59,6 +80,24 @@ var command = &cobra.Command{
 		service := services.NewServiceRegistry(repository, gcs)
 		controller := controllers.NewControllerRegistry(service)
 
+		// 🧹 Sweeper untuk mengembalikan slot Held yang sudah expired ke Available
+		sweeperInterval := config.Config.HoldSweeperIntervalSeconds
+		if sweeperInterval <= 0 {
+			sweeperInterval = 60
+		}
+		go workers.NewHoldSweeper(service, time.Duration(sweeperInterval)*time.Second).Start(context.Background())
+
+		// 📆 Job malam hari untuk memastikan setiap field punya jadwal N hari ke depan
+		daysAhead := config.Config.ScheduleGeneratorDaysAhead
+		if daysAhead <= 0 {
+			daysAhead = 30
+		}
+		generatorHour := 1
+		if hour := config.Config.ScheduleGeneratorHour; hour != nil && *hour >= 0 && *hour <= 23 {
+			generatorHour = *hour
+		}
+		go workers.NewScheduleGenerator(service, daysAhead, generatorHour).Start(context.Background())
+
 		router := gin.Default()
 		router.Use(middlewares.HandlePanic())
 		router.NoRoute(func(c *gin.Context) {
@@ -91,6 +130,7 @@ var command = &cobra.Command{
 		}
 
 		group := router.Group("/api/v1")
+		group.Use(middlewares.ValidateUUIDParam())
 		route := routes.NewRouteRegistry(controller, group, client)
 		route.Serve()
 
@@ -99,6 +139,10 @@ var command = &cobra.Command{
 	},
 }
 
+func init() {
+	command.AddCommand(serveCommand, migrateCommand)
+}
+
 func Run() {
 	err := command.Execute()
 	if err != nil {
@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"context"
+	"field-service/config"
+	"field-service/migrations"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/spf13/cobra"
+)
+
+var migrateCommand = &cobra.Command{
+	Use:   "migrate",
+	Short: "manage database schema migrations",
+}
+
+var migrateUpCommand = &cobra.Command{
+	Use:   "up",
+	Short: "apply all pending migrations",
+	Run: func(c *cobra.Command, args []string) {
+		migrator := newMigrator()
+		executed, err := migrator.Up(context.Background())
+		for _, item := range executed {
+			fmt.Printf("✅ [INFO-MIGRATION] Migration %d_%s berhasil dijalankan\n", item.Version, item.Name)
+		}
+		if err != nil {
+			panic(err)
+		}
+
+		if len(executed) == 0 {
+			fmt.Println("✅ [INFO-MIGRATION] Schema sudah versi terbaru")
+		}
+	},
+}
+
+var migrateDownCommand = &cobra.Command{
+	Use:   "down",
+	Short: "roll back the latest applied migrations",
+	Run: func(c *cobra.Command, args []string) {
+		steps, err := c.Flags().GetInt("steps")
+		if err != nil {
+			panic(err)
+		}
+
+		if steps <= 0 {
+			panic("steps harus lebih dari 0")
+		}
+
+		migrator := newMigrator()
+		reverted, err := migrator.Down(context.Background(), steps)
+		for _, item := range reverted {
+			fmt.Printf("✅ [INFO-MIGRATION] Migration %d_%s berhasil dibatalkan\n", item.Version, item.Name)
+		}
+		if err != nil {
+			panic(err)
+		}
+
+		if len(reverted) == 0 {
+			fmt.Println("⚠️ [WARN-MIGRATION] Tidak ada migration yang bisa dibatalkan")
+		}
+	},
+}
+
+var migrateStatusCommand = &cobra.Command{
+	Use:   "status",
+	Short: "show applied and pending migrations",
+	Run: func(c *cobra.Command, args []string) {
+		migrator := newMigrator()
+		statuses, err := migrator.Status(context.Background())
+		if err != nil {
+			panic(err)
+		}
+
+		writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(writer, "VERSION\tNAME\tAPPLIED AT")
+		for _, item := range statuses {
+			appliedAt := "pending"
+			if item.AppliedAt != nil {
+				appliedAt = item.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(writer, "%d\t%s\t%s\n", item.Version, item.Name, appliedAt)
+		}
+		writer.Flush()
+	},
+}
+
+func init() {
+	migrateDownCommand.Flags().Int("steps", 1, "number of migrations to roll back")
+	migrateCommand.AddCommand(migrateUpCommand, migrateDownCommand, migrateStatusCommand)
+}
+
+// newMigrator membaca config dan membuka koneksi database yang sama dengan serve.
+func newMigrator() *migrations.Migrator {
+	_ = godotenv.Load()
+	config.Init()
+	db, err := config.InitDatabase()
+	if err != nil {
+		panic(err)
+	}
+
+	migrator, err := migrations.NewMigrator(db)
+	if err != nil {
+		panic(err)
+	}
+
+	return migrator
+}
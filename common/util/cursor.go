@@ -0,0 +1,45 @@
+package util
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	errConstant "field-service/constants/error"
+	"time"
+)
+
+// CursorPaginationResult adalah bentuk response keyset pagination. NextCursor null artinya
+// sudah halaman terakhir. TotalData hanya diisi kalau client minta (COUNT(*) mahal di tabel besar).
+type CursorPaginationResult struct {
+	NextCursor *string     `json:"nextCursor"`
+	Limit      int         `json:"limit"`
+	TotalData  *int64      `json:"totalData"`
+	Data       interface{} `json:"data"`
+}
+
+// Cursor menandai posisi baris terakhir di halaman sebelumnya (urutan created_at desc nulls first, id desc).
+// CreatedAt nil artinya baris terakhir belum punya created_at, posisinya cukup ditentukan oleh id.
+type Cursor struct {
+	CreatedAt *time.Time `json:"c,omitempty"`
+	ID        uint       `json:"i"`
+}
+
+// EncodeCursor membuat cursor opaque (base64 URL-safe) dari created_at dan id baris terakhir.
+func EncodeCursor(createdAt *time.Time, id uint) string {
+	payload, _ := json.Marshal(Cursor{CreatedAt: createdAt, ID: id})
+	return base64.RawURLEncoding.EncodeToString(payload)
+}
+
+// DecodeCursor membaca cursor dari EncodeCursor, cursor rusak dikembalikan sebagai ErrInvalidCursor.
+func DecodeCursor(value string) (*Cursor, error) {
+	payload, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, errConstant.ErrInvalidCursor
+	}
+
+	var cursor Cursor
+	err = json.Unmarshal(payload, &cursor)
+	if err != nil || cursor.ID == 0 {
+		return nil, errConstant.ErrInvalidCursor
+	}
+	return &cursor, nil
+}
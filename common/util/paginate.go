@@ -0,0 +1,28 @@
+package util
+
+import (
+	"gorm.io/gorm"
+)
+
+// Paginate menjalankan query count dan query data (order + limit/offset) dari query yang sama,
+// jadi semua Where/Scopes/soft delete yang dipasang di query ikut ke keduanya dan total selalu
+// cocok dengan data. query harus sudah punya Model; Preload hanya berpengaruh ke query data.
+func Paginate(query *gorm.DB, page int, limit int, order string, dest any) (int64, error) {
+	var total int64
+	err := query.Session(&gorm.Session{}).Count(&total).Error
+	if err != nil {
+		return 0, err
+	}
+
+	offset := (page - 1) * limit
+	err = query.Session(&gorm.Session{}).
+		Order(order).
+		Limit(limit).
+		Offset(offset).
+		Find(dest).
+		Error
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
@@ -0,0 +1,103 @@
+package util
+
+import (
+	"errors"
+	errConstant "field-service/constants/error"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+type FilterOperator string
+
+const (
+	FilterEqual        FilterOperator = "="
+	FilterGreaterEqual FilterOperator = ">="
+	FilterLessEqual    FilterOperator = "<="
+	FilterIn           FilterOperator = "IN"
+)
+
+// QueryFilter adalah satu kondisi filter dengan key publik (nama query param), bukan nama kolom.
+type QueryFilter struct {
+	Key      string
+	Operator FilterOperator
+	Value    any
+}
+
+// QueryBuilder memetakan key sort/filter publik ke kolom database. Hanya key yang terdaftar
+// yang bisa dipakai, jadi input dari query param tidak pernah masuk langsung ke SQL.
+type QueryBuilder struct {
+	sortColumns   map[string]string
+	filterColumns map[string]string
+	defaultSort   string
+}
+
+func NewQueryBuilder(sortColumns, filterColumns map[string]string, defaultSort string) *QueryBuilder {
+	return &QueryBuilder{
+		sortColumns:   sortColumns,
+		filterColumns: filterColumns,
+		defaultSort:   defaultSort,
+	}
+}
+
+// OrderBy mengembalikan klausa ORDER BY untuk sortColumn dan sortOrder dari query param.
+// sortColumn kosong memakai defaultSort, sortOrder kosong berarti asc.
+func (q *QueryBuilder) OrderBy(sortColumn, sortOrder *string) (string, error) {
+	if sortColumn == nil || *sortColumn == "" {
+		return q.defaultSort, nil
+	}
+
+	column, ok := q.sortColumns[*sortColumn]
+	if !ok {
+		fmt.Println("❌ [ERROR-QUERY-BUILDER] Sort column tidak diizinkan:", *sortColumn)
+		return "", errConstant.ErrInvalidSortColumn
+	}
+
+	order := "asc"
+	if sortOrder != nil && *sortOrder != "" {
+		order = strings.ToLower(*sortOrder)
+		if order != "asc" && order != "desc" {
+			fmt.Println("❌ [ERROR-QUERY-BUILDER] Sort order tidak valid:", *sortOrder)
+			return "", errConstant.ErrInvalidSortOrder
+		}
+	}
+
+	return fmt.Sprintf("%s %s", column, order), nil
+}
+
+// Scope mengubah daftar filter jadi gorm scope. Key yang tidak terdaftar atau operator yang
+// tidak dikenal menggagalkan query dengan ErrInvalidFilter.
+func (q *QueryBuilder) Scope(filters []QueryFilter) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		for _, filter := range filters {
+			column, ok := q.filterColumns[filter.Key]
+			if !ok {
+				fmt.Println("❌ [ERROR-QUERY-BUILDER] Filter tidak diizinkan:", filter.Key)
+				_ = db.AddError(errConstant.ErrInvalidFilter)
+				return db
+			}
+
+			switch filter.Operator {
+			case FilterEqual, FilterGreaterEqual, FilterLessEqual:
+				db = db.Where(fmt.Sprintf("%s %s ?", column, filter.Operator), filter.Value)
+			case FilterIn:
+				db = db.Where(fmt.Sprintf("%s IN ?", column), filter.Value)
+			default:
+				fmt.Println("❌ [ERROR-QUERY-BUILDER] Operator filter tidak dikenal:", filter.Operator)
+				_ = db.AddError(errConstant.ErrInvalidFilter)
+				return db
+			}
+		}
+		return db
+	}
+}
+
+// QueryError memetakan error dari query yang memakai Scope. Filter yang tidak valid adalah kesalahan
+// input client, jadi dikembalikan sebagai ErrInvalidFilter (400), bukan ErrSQLError.
+func QueryError(err error) error {
+	if errors.Is(err, errConstant.ErrInvalidFilter) {
+		return errConstant.ErrInvalidFilter
+	}
+	return errConstant.ErrSQLError
+}
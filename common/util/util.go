@@ -3,12 +3,14 @@ package util
 import (
 	"crypto/sha256"
 	"encoding/hex"
+	"field-service/constants"
 	"fmt"
 	"math"
 	"os"
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/sirupsen/logrus"
@@ -33,27 +35,34 @@ type PaginationResult struct {
 	Data         interface{} `json:"data"`
 }
 
+// GeneratePagination menyusun response pagination offset. NextPage/PreviousPage bernilai null
+// (bukan 0) kalau tidak ada halaman berikutnya/sebelumnya.
 func GeneratePagination(params PaginationParam) PaginationResult {
-	totalPage := int(math.Ceil(float64(params.Count) / float64(params.Limit)))
+	totalPage := 0
+	if params.Limit > 0 {
+		totalPage = int(math.Ceil(float64(params.Count) / float64(params.Limit)))
+	}
 
 	var (
-		nextPage     int
-		previousPage int
+		nextPage     *int
+		previousPage *int
 	)
 
 	if params.Page < totalPage {
-		nextPage = params.Page + 1
+		next := params.Page + 1
+		nextPage = &next
 	}
 
 	if params.Page > 1 {
-		previousPage = params.Page - 1
+		previous := params.Page - 1
+		previousPage = &previous
 	}
 
 	result := PaginationResult{
 		TotalPage:    totalPage,
 		TotalData:    int(params.Count),
-		NextPage:     &nextPage,
-		PreviousPage: &previousPage,
+		NextPage:     nextPage,
+		PreviousPage: previousPage,
 		Page:         params.Page,
 		Limit:        params.Limit,
 		Data:         params.Data,
@@ -167,3 +176,37 @@ func BindFromConsul(dest any, endPoint, path string) error {
 
 	return nil
 }
+
+// LoadLocation mengembalikan zona waktu berdasarkan nama IANA (misal "Asia/Makassar").
+// Nama kosong atau tidak dikenal jatuh ke constants.DefaultTimezone.
+func LoadLocation(name string) *time.Location {
+	if name == "" {
+		name = constants.DefaultTimezone
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		logrus.Errorf("timezone %s tidak dikenal, pakai %s: %v", name, constants.DefaultTimezone, err)
+		loc, err = time.LoadLocation(constants.DefaultTimezone)
+		if err != nil {
+			return time.UTC
+		}
+	}
+	return loc
+}
+
+// DateIn mengembalikan tanggal kalender t di zona loc sebagai jam 00:00 UTC,
+// format yang sama dengan hasil time.Parse(time.DateOnly, ...) untuk kolom date.
+func DateIn(t time.Time, loc *time.Location) time.Time {
+	year, month, day := t.In(loc).Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// EndOfDayClock mengubah jam selesai constants.MidnightTime menjadi "24:00:00" supaya bisa
+// dibandingkan dengan kolom time di Postgres sebagai akhir hari. Jam lain dikembalikan apa adanya.
+func EndOfDayClock(clock string) string {
+	if clock == constants.MidnightTime {
+		return "24:00:00"
+	}
+	return clock
+}
@@ -20,6 +20,13 @@ type AppConfig struct {
 	RateLimiterMaxRequests float64         `json:"rateLimiterMaxRequests"`
 	RateLimiterTimeSeconds int             `json:"rateLimiterTimeSeconds"`
 	InternalService        InternalService `json:"internalService"`
+	// durasi hold slot saat customer checkout dan interval sweeper hold yang expired
+	HoldDurationMinutes        int `json:"holdDurationMinutes"`
+	HoldSweeperIntervalSeconds int `json:"holdSweeperIntervalSeconds"`
+	// job generate schedule otomatis: jumlah hari ke depan dan jam (0-23) job dijalankan setiap malam.
+	// ScheduleGeneratorHour pointer supaya jam 0 (tengah malam) bisa dibedakan dari "tidak diisi" (default jam 1).
+	ScheduleGeneratorDaysAhead int  `json:"scheduleGeneratorDaysAhead"`
+	ScheduleGeneratorHour      *int `json:"scheduleGeneratorHour"`
 	// GCSType                    string          `json:"gcsType"`
 	// GCSProjectID               string          `json:"gcsProjectID"`
 	// GCSPrivateKeyID            string          `json:"gcsPrivateKeyID"`
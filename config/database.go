@@ -20,7 +20,10 @@ func InitDatabase() (*gorm.DB, error) {
 		config.Database.Name,
 	)
 
-	db, err := gorm.Open(postgres.Open(uri), &gorm.Config{})
+	db, err := gorm.Open(postgres.Open(uri), &gorm.Config{
+		// TranslateError supaya unique violation jadi gorm.ErrDuplicatedKey
+		TranslateError: true,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,13 @@
+package error
+
+import "errors"
+
+var (
+	ErrClosureNotFound         = errors.New("closure not found")
+	ErrClosureInvalidDateRange = errors.New("invalid closure date range")
+)
+
+var ClosureErrors = []error{
+	ErrClosureNotFound,
+	ErrClosureInvalidDateRange,
+}
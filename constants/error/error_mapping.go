@@ -1,9 +1,13 @@
 package error
 
 import (
+	"errors"
+	errClosure "field-service/constants/error/closure"
 	errField "field-service/constants/error/field"
 	errFieldSchedule "field-service/constants/error/fieldschedule"
+	errPricingRule "field-service/constants/error/pricingrule"
 	errTime "field-service/constants/error/time"
+	errVenue "field-service/constants/error/venue"
 	"fmt"
 )
 
@@ -13,6 +17,9 @@ func ErrMapping(err error) bool {
 		FieldErrors         = errField.FieldErrors
 		FieldScheduleErrors = errFieldSchedule.FieldScheduleErrors
 		TimeErrors          = errTime.TimeErrors
+		ClosureErrors       = errClosure.ClosureErrors
+		PricingRuleErrors   = errPricingRule.PricingRuleErrors
+		VenueErrors         = errVenue.VenueErrors
 	)
 
 	allErrors := make([]error, 0)
@@ -20,10 +27,13 @@ func ErrMapping(err error) bool {
 	allErrors = append(allErrors, FieldErrors...)
 	allErrors = append(allErrors, FieldScheduleErrors...)
 	allErrors = append(allErrors, TimeErrors...)
+	allErrors = append(allErrors, ClosureErrors...)
+	allErrors = append(allErrors, PricingRuleErrors...)
+	allErrors = append(allErrors, VenueErrors...)
 
 	for _, item := range allErrors {
 		fmt.Println("🔍 [DEBUG-CONSTANTS-ERROR-MAPPING] Error:", item.Error())
-		if err.Error() == item.Error() {
+		if err.Error() == item.Error() || errors.Is(err, item) {
 			fmt.Println("🔍 [DEBUG-CONSTANTS-ERROR-MAPPING] Error found:", item.Error())
 			return true
 		}
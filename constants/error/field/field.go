@@ -3,9 +3,11 @@ package error
 import "errors"
 
 var (
-	ErrFieldNotFound = errors.New("field not found")
+	ErrFieldNotFound          = errors.New("field not found")
+	ErrFieldHasFutureBookings = errors.New("field has upcoming booked schedules")
 )
 
 var FieldErrors = []error{
 	ErrFieldNotFound,
+	ErrFieldHasFutureBookings,
 }
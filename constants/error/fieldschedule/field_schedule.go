@@ -1,13 +1,47 @@
 package error
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
-	ErrFieldScheduleNotFound = errors.New("field schedule not found")
-	ErrFieldScheduleIsExist  = errors.New("field schedule already exists")
+	ErrFieldScheduleNotFound          = errors.New("field schedule not found")
+	ErrFieldScheduleIsExist           = errors.New("field schedule already exists")
+	ErrFieldScheduleIsBooked          = errors.New("field schedule already booked")
+	ErrFieldScheduleIsHeld            = errors.New("field schedule is being held by another customer")
+	ErrFieldScheduleNotHeld           = errors.New("field schedule is not held by this customer")
+	ErrFieldScheduleNotBooked         = errors.New("field schedule is not booked")
+	ErrFieldScheduleInvalidTransition = errors.New("invalid field schedule status transition")
+	ErrInvalidDateRange               = errors.New("invalid schedule date range")
+	ErrInvalidTimeRange               = errors.New("invalid schedule time range")
+	ErrFieldScheduleRangeNotAvailable = errors.New("no contiguous field schedules for the requested time range")
 )
 
 var FieldScheduleErrors = []error{
 	ErrFieldScheduleNotFound,
 	ErrFieldScheduleIsExist,
+	ErrFieldScheduleIsBooked,
+	ErrFieldScheduleIsHeld,
+	ErrFieldScheduleNotHeld,
+	ErrFieldScheduleNotBooked,
+	ErrFieldScheduleInvalidTransition,
+	ErrInvalidDateRange,
+	ErrInvalidTimeRange,
+	ErrFieldScheduleRangeNotAvailable,
+}
+
+// InvalidTransitionError dikembalikan ketika status jadwal dipindah ke status yang tidak legal.
+// errors.Is(err, ErrFieldScheduleInvalidTransition) bernilai true untuk error ini.
+type InvalidTransitionError struct {
+	From string
+	To   string
+}
+
+func (e *InvalidTransitionError) Error() string {
+	return fmt.Sprintf("%s from %s to %s", ErrFieldScheduleInvalidTransition.Error(), e.From, e.To)
+}
+
+func (e *InvalidTransitionError) Unwrap() error {
+	return ErrFieldScheduleInvalidTransition
 }
@@ -11,6 +11,11 @@ var (
 	ErrInvalidUploadFile   = errors.New("invalid upload file")
 	ErrSizeTooBig          = errors.New("size too big")
 	ErrForbidden           = errors.New("forbidden")
+	ErrInvalidSortColumn   = errors.New("invalid sort column")
+	ErrInvalidSortOrder    = errors.New("invalid sort order, must be asc or desc")
+	ErrInvalidFilter       = errors.New("invalid filter")
+	ErrInvalidCursor       = errors.New("invalid pagination cursor")
+	ErrInvalidUUID         = errors.New("invalid uuid")
 )
 
 var GeneralErrors = []error{
@@ -20,4 +25,9 @@ var GeneralErrors = []error{
 	ErrUnauthorized,
 	ErrInvalidToken,
 	ErrForbidden,
+	ErrInvalidSortColumn,
+	ErrInvalidSortOrder,
+	ErrInvalidFilter,
+	ErrInvalidCursor,
+	ErrInvalidUUID,
 }
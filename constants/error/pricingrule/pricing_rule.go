@@ -0,0 +1,15 @@
+package error
+
+import "errors"
+
+var (
+	ErrPricingRuleNotFound         = errors.New("pricing rule not found")
+	ErrPricingRuleInvalidTimeRange = errors.New("invalid pricing rule time range")
+	ErrPricingRuleInvalidDate      = errors.New("invalid pricing rule date")
+)
+
+var PricingRuleErrors = []error{
+	ErrPricingRuleNotFound,
+	ErrPricingRuleInvalidTimeRange,
+	ErrPricingRuleInvalidDate,
+}
@@ -3,9 +3,21 @@ package error
 import "errors"
 
 var (
-	ErrTimeNotFound = errors.New("field not found")
+	ErrTimeNotFound        = errors.New("time not found")
+	ErrTimeInvalidFormat   = errors.New("invalid time format, use HH:MM or HH:MM:SS")
+	ErrTimeInvalidRange    = errors.New("end time must be after start time")
+	ErrTimeInvalidDuration = errors.New("time slot duration must be 30, 60, 90 or 120 minutes")
+	ErrTimeOverlap         = errors.New("time slot overlaps an existing time slot")
+	ErrTimeInUse           = errors.New("time slot is still used by schedules, including past bookings")
+	ErrTimeNotInCatalog    = errors.New("time slot is not in the field's time catalog")
 )
 
 var TimeErrors = []error{
 	ErrTimeNotFound,
+	ErrTimeInvalidFormat,
+	ErrTimeInvalidRange,
+	ErrTimeInvalidDuration,
+	ErrTimeOverlap,
+	ErrTimeInUse,
+	ErrTimeNotInCatalog,
 }
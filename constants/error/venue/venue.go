@@ -0,0 +1,17 @@
+package error
+
+import "errors"
+
+var (
+	ErrVenueNotFound            = errors.New("venue not found")
+	ErrVenueInvalidTimezone     = errors.New("invalid venue timezone")
+	ErrVenueInvalidOpeningHours = errors.New("invalid venue opening hours")
+	ErrVenueHasFields           = errors.New("venue still has fields")
+)
+
+var VenueErrors = []error{
+	ErrVenueNotFound,
+	ErrVenueInvalidTimezone,
+	ErrVenueInvalidOpeningHours,
+	ErrVenueHasFields,
+}
@@ -0,0 +1,40 @@
+package constants
+
+type SportType string
+
+const (
+	SportFutsal     SportType = "futsal"
+	SportMiniSoccer SportType = "mini_soccer"
+	SportBadminton  SportType = "badminton"
+	SportBasketball SportType = "basketball"
+	SportVolleyball SportType = "volleyball"
+	SportTennis     SportType = "tennis"
+	SportPadel      SportType = "padel"
+)
+
+type FieldSurface string
+
+const (
+	SurfaceSyntheticGrass FieldSurface = "synthetic_grass"
+	SurfaceNaturalGrass   FieldSurface = "natural_grass"
+	SurfaceVinyl          FieldSurface = "vinyl"
+	SurfaceParquet        FieldSurface = "parquet"
+	SurfaceInterlock      FieldSurface = "interlock"
+	SurfaceCement         FieldSurface = "cement"
+	SurfaceHardCourt      FieldSurface = "hard_court"
+)
+
+type FieldAmenity string
+
+const (
+	AmenityParking   FieldAmenity = "parking"
+	AmenityShower    FieldAmenity = "shower"
+	AmenityLocker    FieldAmenity = "locker"
+	AmenityToilet    FieldAmenity = "toilet"
+	AmenityCanteen   FieldAmenity = "canteen"
+	AmenityMusholla  FieldAmenity = "musholla"
+	AmenityWifi      FieldAmenity = "wifi"
+	AmenityLighting  FieldAmenity = "lighting"
+	AmenityTribune   FieldAmenity = "tribune"
+	AmenityEquipment FieldAmenity = "equipment_rental"
+)
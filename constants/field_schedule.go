@@ -4,21 +4,47 @@ type FieldScheduleStatusName string
 type FieldScheduleStatus int
 
 const (
-	Available FieldScheduleStatus = 100
-	Booked    FieldScheduleStatus = 200
+	Available   FieldScheduleStatus = 100
+	Held        FieldScheduleStatus = 150
+	Booked      FieldScheduleStatus = 200
+	Blocked     FieldScheduleStatus = 300
+	Maintenance FieldScheduleStatus = 400
+	Cancelled   FieldScheduleStatus = 500
 
-	AvailableString FieldScheduleStatusName = "Available"
-	BookedString    FieldScheduleStatusName = "Booked"
+	AvailableString   FieldScheduleStatusName = "Available"
+	HeldString        FieldScheduleStatusName = "Held"
+	BookedString      FieldScheduleStatusName = "Booked"
+	BlockedString     FieldScheduleStatusName = "Blocked"
+	MaintenanceString FieldScheduleStatusName = "Maintenance"
+	CancelledString   FieldScheduleStatusName = "Cancelled"
 )
 
 var mapFieldScheduleStatusIntToString = map[FieldScheduleStatus]FieldScheduleStatusName{
-	Available: AvailableString,
-	Booked:    BookedString,
+	Available:   AvailableString,
+	Held:        HeldString,
+	Booked:      BookedString,
+	Blocked:     BlockedString,
+	Maintenance: MaintenanceString,
+	Cancelled:   CancelledString,
 }
 
 var mapFieldScheduleStatusStringToInt = map[FieldScheduleStatusName]FieldScheduleStatus{
-	AvailableString: Available,
-	BookedString:    Booked,
+	AvailableString:   Available,
+	HeldString:        Held,
+	BookedString:      Booked,
+	BlockedString:     Blocked,
+	MaintenanceString: Maintenance,
+	CancelledString:   Cancelled,
+}
+
+// mapFieldScheduleStatusTransitions berisi daftar status tujuan yang legal dari setiap status.
+var mapFieldScheduleStatusTransitions = map[FieldScheduleStatus][]FieldScheduleStatus{
+	Available:   {Held, Booked, Blocked, Maintenance},
+	Held:        {Available, Held, Booked, Blocked},
+	Booked:      {Available, Cancelled, Blocked},
+	Blocked:     {Available},
+	Maintenance: {Available},
+	Cancelled:   {Available},
 }
 
 func (f FieldScheduleStatus) GetStatusString() FieldScheduleStatusName {
@@ -28,3 +54,24 @@ func (f FieldScheduleStatus) GetStatusString() FieldScheduleStatusName {
 func (f FieldScheduleStatusName) GetStatusInt() FieldScheduleStatus {
 	return mapFieldScheduleStatusStringToInt[f]
 }
+
+// CanTransitionTo mengecek apakah perpindahan status dari f ke next diizinkan.
+func (f FieldScheduleStatus) CanTransitionTo(next FieldScheduleStatus) bool {
+	for _, item := range mapFieldScheduleStatusTransitions[f] {
+		if item == next {
+			return true
+		}
+	}
+	return false
+}
+
+// FieldScheduleStatusesAllowedTo mengembalikan semua status yang boleh pindah ke next.
+func FieldScheduleStatusesAllowedTo(next FieldScheduleStatus) []FieldScheduleStatus {
+	statuses := make([]FieldScheduleStatus, 0, len(mapFieldScheduleStatusTransitions))
+	for status := range mapFieldScheduleStatusTransitions {
+		if status.CanTransitionTo(next) {
+			statuses = append(statuses, status)
+		}
+	}
+	return statuses
+}
@@ -0,0 +1,10 @@
+package constants
+
+type FieldScheduleEventType string
+
+const (
+	// FieldScheduleBookingRescheduled: slot yang sudah dibooking dipindah tanggal/jamnya oleh admin (force).
+	FieldScheduleBookingRescheduled FieldScheduleEventType = "BookingRescheduled"
+	// FieldScheduleBookingVoided: slot yang sudah dibooking dihapus oleh admin (force).
+	FieldScheduleBookingVoided FieldScheduleEventType = "BookingVoided"
+)
@@ -0,0 +1,7 @@
+package constants
+
+// key untuk pg_advisory_lock supaya job yang sama tidak jalan bersamaan di beberapa replica
+const (
+	ScheduleGeneratorLockKey int64 = 1001
+	MigrationLockKey         int64 = 1002
+)
@@ -0,0 +1,12 @@
+package constants
+
+type PricingAdjustmentType string
+
+const (
+	// PricingPercentage menaikkan/menurunkan harga sebesar Value persen.
+	PricingPercentage PricingAdjustmentType = "Percentage"
+	// PricingFixed menambah/mengurangi harga sebesar Value rupiah.
+	PricingFixed PricingAdjustmentType = "Fixed"
+	// PricingOverride mengganti harga menjadi Value rupiah.
+	PricingOverride PricingAdjustmentType = "Override"
+)
@@ -0,0 +1,8 @@
+package constants
+
+// TimeSlotDurations berisi durasi time slot (dalam menit) yang boleh dibuat di katalog waktu.
+var TimeSlotDurations = []int{30, 60, 90, 120}
+
+// MidnightTime adalah jam selesai slot yang berakhir tepat tengah malam. Disimpan sebagai 00:00:00
+// tapi dihitung sebagai 24:00 (akhir hari yang sama), bukan awal hari.
+const MidnightTime = "00:00:00"
@@ -0,0 +1,4 @@
+package constants
+
+// DefaultTimezone dipakai untuk venue yang tidak mengisi timezone (WIB).
+const DefaultTimezone = "Asia/Jakarta"
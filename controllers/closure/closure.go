@@ -0,0 +1,146 @@
+package controllers
+
+import (
+	errValidation "field-service/common/error"
+	"field-service/common/response"
+	"field-service/domain/dto"
+	"field-service/services"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type ClosureController struct {
+	service services.IServiceRegistry
+}
+
+type IClosureController interface {
+	GetAll(*gin.Context)
+	GetByUUID(*gin.Context)
+	Create(*gin.Context)
+	Delete(*gin.Context)
+}
+
+func NewClosureController(service services.IServiceRegistry) IClosureController {
+	return &ClosureController{service: service}
+}
+
+func (cl *ClosureController) GetAll(c *gin.Context) {
+	// 🚀 Step 1: Ambil semua data closure dari service
+	result, err := cl.service.GetClosure().GetAll(c)
+	if err != nil {
+		// 🛑 Step 2: Jika ada error, kirim response error
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 3: Kirim response sukses
+	response.HttpResponse(response.ParamHttpResp{
+		Code: http.StatusOK,
+		Gin:  c,
+		Data: result,
+	})
+}
+
+func (cl *ClosureController) GetByUUID(c *gin.Context) {
+	// 🚀 Step 1: Ambil UUID dari parameter URL
+	uuid := c.Param("uuid")
+
+	// 🚀 Step 2: Ambil data closure berdasarkan UUID dari service
+	result, err := cl.service.GetClosure().GetByUUID(c, uuid)
+	if err != nil {
+		// 🛑 Step 3: Jika ada error, kirim response error
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 4: Kirim response sukses
+	response.HttpResponse(response.ParamHttpResp{
+		Code: http.StatusOK,
+		Gin:  c,
+		Data: result,
+	})
+}
+
+func (cl *ClosureController) Create(c *gin.Context) {
+	// 🧾 Step 1: Bind body JSON ke struct request
+	var request dto.ClosureRequest
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// 📜 Step 2: Validasi input
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		fmt.Println("❌ [ERROR-CLOSURE-CONTROLLER] Gagal validasi input:", err)
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errValidation.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code:    http.StatusBadRequest,
+			Err:     err,
+			Message: &errMessage,
+			Data:    errResponse,
+			Gin:     c,
+		})
+		return
+	}
+
+	// 🚀 Step 3: Buat closure dan blokir schedule yang terdampak
+	result, err := cl.service.GetClosure().Create(c, &request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 4: Kirim response sukses
+	response.HttpResponse(response.ParamHttpResp{
+		Code: http.StatusCreated,
+		Gin:  c,
+		Data: result,
+	})
+}
+
+func (cl *ClosureController) Delete(c *gin.Context) {
+	// 🚀 Step 1: Ambil parameter UUID dari URL
+	uuid := c.Param("uuid")
+
+	// 📞 Step 2: Hapus closure dan buka kembali schedule yang diblokir
+	err := cl.service.GetClosure().Delete(c, uuid)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-CLOSURE-CONTROLLER] Gagal hapus closure (UUID: %s): %v\n", uuid, err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 3: Kirim response sukses
+	response.HttpResponse(response.ParamHttpResp{
+		Code: http.StatusOK,
+		Data: "Data closure berhasil dihapus",
+		Gin:  c,
+	})
+}
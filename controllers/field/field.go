@@ -24,6 +24,8 @@ type IFieldController interface {
 	Create(*gin.Context)
 	Update(*gin.Context)
 	Delete(*gin.Context)
+	GetAllDeleted(*gin.Context)
+	Restore(*gin.Context)
 }
 
 func NewFieldController(service services.IServiceRegistry) IFieldController {
@@ -95,11 +97,44 @@ func (f *FieldController) GetAllWithPagination(c *gin.Context) {
 }
 
 func (f *FieldController) GetAllWithoutPagination(c *gin.Context) {
-	// 🚀 Step 1: Panggil service untuk ambil semua data field
-	result, err := f.service.GetField().GetAllWithoutPagination(c)
+	// 🚀 Step 1: Ambil filter dari query (misal ?venueID=...)
+	var filter dto.FieldFilterParam
+	err := c.ShouldBindQuery(&filter)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELD-CONTROLLER] Gagal binding query params: %v\n", err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 2: Validasi filter (misalnya: minCapacity, surface, sportType, amenities)
+	validate := validator.New()
+	err = validate.Struct(filter)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELD-CONTROLLER] Validasi gagal: %v\n", err)
+
+		// Ambil pesan error dari validasi
+		// dan buat response error
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errValidation.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code:    http.StatusBadRequest,
+			Err:     err,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Gin:     c,
+		})
+		return
+	}
+
+	// 🚀 Step 3: Panggil service untuk ambil semua data field
+	result, err := f.service.GetField().GetAllWithoutPagination(c, &filter)
 
 	if err != nil {
-		// ❌ Step 2: Kalau error saat ambil data, tampilkan pesan error + kirim response error ke client
+		// ❌ Step 4: Kalau error saat ambil data, tampilkan pesan error + kirim response error ke client
 		fmt.Printf("❌ [ERROR-FIELD-CONTROLLER] Gagal ambil data field: %v\n", err)
 		response.HttpResponse(response.ParamHttpResp{
 			Code: http.StatusBadRequest,
@@ -109,7 +144,7 @@ func (f *FieldController) GetAllWithoutPagination(c *gin.Context) {
 		return
 	}
 
-	// ✅ Step 3: Kalau sukses, kirim data ke client dengan status 200 (OK)
+	// ✅ Step 5: Kalau sukses, kirim data ke client dengan status 200 (OK)
 	response.HttpResponse(response.ParamHttpResp{
 		Code: http.StatusOK,
 		Data: result, // Kirim data hasil dari service
@@ -295,3 +330,51 @@ func (f *FieldController) Delete(c *gin.Context) {
 	})
 	fmt.Printf("✅ [INFO-FIELD-CONTROLLER] Berhasil hapus data field (UUID: %s)\n", uuid)
 }
+
+func (f *FieldController) GetAllDeleted(c *gin.Context) {
+	// 🚀 Step 1: Ambil semua field yang sudah dihapus (trash)
+	result, err := f.service.GetField().GetAllDeleted(c)
+	if err != nil {
+		// ❌ Step 2: Kalau gagal, kirim response error
+		fmt.Printf("❌ [ERROR-FIELD-CONTROLLER] Gagal ambil data field terhapus: %v\n", err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 3: Kalau sukses, kirim data ke client
+	response.HttpResponse(response.ParamHttpResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  c,
+	})
+}
+
+func (f *FieldController) Restore(c *gin.Context) {
+	// 🚀 Step 1: Ambil parameter UUID dari URL
+	uuid := c.Param("uuid")
+
+	// 📞 Step 2: Panggil service untuk restore field (beserta jadwal yang ikut terhapus)
+	result, err := f.service.GetField().Restore(c, uuid)
+	if err != nil {
+		// ❌ Step 3: Kalau gagal, kirim response error
+		fmt.Printf("❌ [ERROR-FIELD-CONTROLLER] Gagal restore data field (UUID: %s): %v\n", uuid, err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 4: Kalau sukses, kirim data field yang sudah di-restore
+	response.HttpResponse(response.ParamHttpResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  c,
+	})
+	fmt.Printf("✅ [INFO-FIELD-CONTROLLER] Berhasil restore data field (UUID: %s)\n", uuid)
+}
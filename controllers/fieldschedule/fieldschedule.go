@@ -18,13 +18,24 @@ type FieldScheduleController struct {
 
 type IFieldScheduleController interface {
 	GetAllWithPagination(*gin.Context)
+	GetAllWithCursor(*gin.Context)
+	GetAllDeletedWithPagination(*gin.Context)
+	Restore(*gin.Context)
 	GetAllByFieldIDAndDate(*gin.Context)
 	GetByUUID(*gin.Context)
+	SearchAvailability(*gin.Context)
 	Create(*gin.Context)
 	Update(*gin.Context)
 	UpdateStatus(*gin.Context)
+	BookRange(*gin.Context)
+	Hold(*gin.Context)
+	ReleaseHold(*gin.Context)
+	Release(*gin.Context)
+	ChangeStatus(*gin.Context)
 	Delete(*gin.Context)
+	GetEvents(*gin.Context)
 	GenerateScheduleForOneMonth(*gin.Context)
+	Generate(*gin.Context)
 }
 
 func NewFieldScheduleController(service services.IServiceRegistry) IFieldScheduleController {
@@ -95,6 +106,58 @@ func (f *FieldScheduleController) GetAllWithPagination(c *gin.Context) {
 	})
 }
 
+func (f *FieldScheduleController) GetAllWithCursor(c *gin.Context) {
+	// 🚀 Step 1: Binding query parameter (?limit=...&cursor=...&withTotal=...)
+	var params dto.FieldScheduleCursorRequestParam
+	err := c.ShouldBindQuery(&params)
+	fmt.Printf("📥 [DEBUG-FIELDSCHEDULE-CONTROLLER] Query Params: %+v\n", params)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Gagal binding query params: %v\n", err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 2: Validasi query parameter
+	validate := validator.New()
+	err = validate.Struct(params)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Validasi gagal: %v\n", err)
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errValidation.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code:    http.StatusBadRequest,
+			Err:     err,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Gin:     c,
+		})
+		return
+	}
+
+	// 🔄 Step 3: Ambil data dengan cursor pagination
+	result, err := f.service.GetFieldSchedule().GetAllWithCursor(c, &params)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Gagal ambil data field schedule: %v\n", err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 4: Kirim response sukses
+	response.HttpResponse(response.ParamHttpResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  c,
+	})
+}
+
 func (f *FieldScheduleController) GetAllByFieldIDAndDate(c *gin.Context) {
 	// 📦 Step 1: Siapkan struct untuk menampung query parameter dari URL (?date=...)
 	var params dto.FieldScheduleByFieldIDAndDateRequestParam
@@ -162,6 +225,58 @@ func (f *FieldScheduleController) GetAllByFieldIDAndDate(c *gin.Context) {
 	})
 }
 
+func (f *FieldScheduleController) SearchAvailability(c *gin.Context) {
+	// 📦 Step 1: Ambil query parameter (?date=...&startTime=...&endTime=...&sportType=...)
+	var params dto.FieldAvailabilityRequestParam
+	err := c.ShouldBindQuery(&params)
+	fmt.Printf("📥 [DEBUG-FIELDSCHEDULE-CONTROLLER] Query Params: %+v\n", params)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Gagal binding query params: %v\n", err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 2: Validasi query parameter
+	validate := validator.New()
+	err = validate.Struct(params)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Validasi gagal: %v\n", err)
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errValidation.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code:    http.StatusBadRequest,
+			Err:     err,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Gin:     c,
+		})
+		return
+	}
+
+	// 🚀 Step 3: Cari field yang punya slot kosong
+	result, err := f.service.GetFieldSchedule().SearchAvailability(c, &params)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Gagal cari availability: %v\n", err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 4: Kirim response sukses
+	response.HttpResponse(response.ParamHttpResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  c,
+	})
+}
+
 func (f *FieldScheduleController) GetByUUID(c *gin.Context) {
 	// 🚀 Step 1: Ambil UUID dari URL
 	uuid := c.Param("uuid")
@@ -226,7 +341,7 @@ func (f *FieldScheduleController) Create(c *gin.Context) {
 	}
 
 	// 🚀 Step 4: Panggil service untuk simpan data field schedule ke database
-	err = f.service.GetFieldSchedule().Create(c, &params)
+	result, err := f.service.GetFieldSchedule().Create(c, &params)
 	if err != nil {
 		// ❌ Jika gagal simpan (misal karena konflik jadwal atau DB error), kirim error
 		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Gagal membuat field schedule: %v\n", err)
@@ -238,9 +353,10 @@ func (f *FieldScheduleController) Create(c *gin.Context) {
 		return
 	}
 
-	// ✅ Step 5: Jika berhasil, kirim response sukses dengan status 201 (Created)
+	// ✅ Step 5: Jika berhasil, kirim response sukses dengan status 201 (Created) + laporan created/skipped
 	response.HttpResponse(response.ParamHttpResp{
 		Code: http.StatusCreated,
+		Data: result,
 		Gin:  c,
 	})
 }
@@ -284,7 +400,7 @@ func (f *FieldScheduleController) GenerateScheduleForOneMonth(c *gin.Context) {
 	}
 
 	// 🚀 Step 4: Panggil service untuk proses generate schedule sebulan ke database
-	err = f.service.GetFieldSchedule().GenerateScheduleForOneMonth(c, &params)
+	result, err := f.service.GetFieldSchedule().GenerateScheduleForOneMonth(c, &params)
 	if err != nil {
 		// ❌ Jika gagal simpan, kirim error
 		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Gagal membuat field schedule: %v\n", err)
@@ -296,9 +412,63 @@ func (f *FieldScheduleController) GenerateScheduleForOneMonth(c *gin.Context) {
 		return
 	}
 
-	// ✅ Step 5: Jika berhasil, kirim response sukses dengan status 201 (Created)
+	// ✅ Step 5: Jika berhasil, kirim response sukses dengan status 201 (Created) + laporan created/skipped
 	response.HttpResponse(response.ParamHttpResp{
 		Code: http.StatusCreated,
+		Data: result,
+		Gin:  c,
+	})
+}
+
+func (f *FieldScheduleController) Generate(c *gin.Context) {
+	// 🧾 Step 1: Siapkan struct untuk menampung request dari client (body JSON)
+	var params dto.GenerateFieldScheduleRequest
+
+	// 🧲 Step 2: Ambil data dari body JSON dan simpan ke struct params
+	err := c.ShouldBindJSON(&params)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Gagal binding JSON: %v\n", err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 3: Validasi input (fieldId, startDate, endDate, template weekday 0-6)
+	validate := validator.New()
+	err = validate.Struct(params)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Validasi gagal: %v\n", err)
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errValidation.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code:    http.StatusBadRequest,
+			Err:     err,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Gin:     c,
+		})
+		return
+	}
+
+	// 🚀 Step 4: Panggil service untuk generate schedule sesuai rentang tanggal dan template
+	result, err := f.service.GetFieldSchedule().Generate(c, &params)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Gagal generate field schedule: %v\n", err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 5: Jika berhasil, kirim response sukses dengan status 201 (Created) + laporan created/skipped
+	response.HttpResponse(response.ParamHttpResp{
+		Code: http.StatusCreated,
+		Data: result,
 		Gin:  c,
 	})
 }
@@ -414,15 +584,307 @@ func (f *FieldScheduleController) UpdateStatus(c *gin.Context) {
 	})
 }
 
+func (f *FieldScheduleController) BookRange(c *gin.Context) {
+	// 🧾 Step 1: Siapkan struct untuk menampung request dari client (body JSON)
+	var request dto.BookRangeFieldScheduleRequest
+
+	// 🧲 Step 2: Ambil data dari body JSON dan simpan ke struct request
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Gagal binding JSON: %v\n", err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 3: Validasi input
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Validasi gagal: %v\n", err)
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errValidation.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code:    http.StatusBadRequest,
+			Err:     err,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Gin:     c,
+		})
+		return
+	}
+
+	// 🚀 Step 4: Panggil service untuk booking beberapa jam berurutan sekaligus
+	result, err := f.service.GetFieldSchedule().BookRange(c, &request)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Gagal booking range field schedule: %v\n", err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 5: Jika berhasil, kirim response sukses dengan status 200 (Ok)
+	response.HttpResponse(response.ParamHttpResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  c,
+	})
+}
+
+func (f *FieldScheduleController) Hold(c *gin.Context) {
+	// 🧾 Step 1: Siapkan struct untuk menampung request dari client (body JSON)
+	var request dto.HoldFieldScheduleRequest
+
+	// 🧲 Step 2: Ambil data dari body JSON dan simpan ke struct request
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Gagal binding JSON: %v\n", err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 3: Validasi input
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Validasi gagal: %v\n", err)
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errValidation.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code:    http.StatusBadRequest,
+			Err:     err,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Gin:     c,
+		})
+		return
+	}
+
+	// 🚀 Step 4: Panggil service untuk hold slot selama checkout
+	result, err := f.service.GetFieldSchedule().Hold(c, &request)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Gagal hold field schedule: %v\n", err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 5: Jika berhasil, kirim response sukses dengan status 200 (Ok)
+	response.HttpResponse(response.ParamHttpResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  c,
+	})
+}
+
+func (f *FieldScheduleController) ReleaseHold(c *gin.Context) {
+	// 🧾 Step 1: Siapkan struct untuk menampung request dari client (body JSON)
+	var request dto.ReleaseHoldFieldScheduleRequest
+
+	// 🧲 Step 2: Ambil data dari body JSON dan simpan ke struct request
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Gagal binding JSON: %v\n", err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 3: Validasi input
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Validasi gagal: %v\n", err)
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errValidation.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code:    http.StatusBadRequest,
+			Err:     err,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Gin:     c,
+		})
+		return
+	}
+
+	// 🚀 Step 4: Panggil service untuk melepas hold
+	err = f.service.GetFieldSchedule().ReleaseHold(c, &request)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Gagal release hold field schedule: %v\n", err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 5: Jika berhasil, kirim response sukses dengan status 200 (Ok)
+	response.HttpResponse(response.ParamHttpResp{
+		Code: http.StatusOK,
+		Gin:  c,
+	})
+}
+
+func (f *FieldScheduleController) Release(c *gin.Context) {
+	// 🧾 Step 1: Siapkan struct untuk menampung request dari client (body JSON)
+	var request dto.ReleaseFieldScheduleRequest
+
+	// 🧲 Step 2: Ambil data dari body JSON dan simpan ke struct request
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Gagal binding JSON: %v\n", err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 3: Validasi input (UUID jadwal, alasan dan siapa yang melepas wajib diisi)
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Validasi gagal: %v\n", err)
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errValidation.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code:    http.StatusBadRequest,
+			Err:     err,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Gin:     c,
+		})
+		return
+	}
+
+	// 🚀 Step 4: Panggil service untuk melepas slot yang sudah dibooking
+	err = f.service.GetFieldSchedule().Release(c, &request)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Gagal release field schedule: %v\n", err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 5: Jika berhasil, kirim response sukses dengan status 200 (Ok)
+	response.HttpResponse(response.ParamHttpResp{
+		Code: http.StatusOK,
+		Gin:  c,
+	})
+}
+
+func (f *FieldScheduleController) ChangeStatus(c *gin.Context) {
+	// 🧾 Step 1: Siapkan struct untuk menampung request dari client (body JSON)
+	var request dto.ChangeStatusFieldScheduleRequest
+
+	// 🧲 Step 2: Ambil data dari body JSON dan simpan ke struct request
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Gagal binding JSON: %v\n", err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 3: Validasi input (status hanya boleh Available, Blocked, Maintenance, Cancelled)
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Validasi gagal: %v\n", err)
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errValidation.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code:    http.StatusBadRequest,
+			Err:     err,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Gin:     c,
+		})
+		return
+	}
+
+	// 🚀 Step 4: Panggil service untuk ubah status jadwal
+	err = f.service.GetFieldSchedule().ChangeStatus(c, &request)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Gagal ubah status field schedule: %v\n", err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 5: Jika berhasil, kirim response sukses dengan status 200 (Ok)
+	response.HttpResponse(response.ParamHttpResp{
+		Code: http.StatusOK,
+		Gin:  c,
+	})
+}
+
 func (f *FieldScheduleController) Delete(c *gin.Context) {
 	// 🚀 Step 1: Ambil UUID dari URL
 	uuid := c.Param("uuid")
 	fmt.Printf("🔍 [DEBUG-FIELDSCHEDULE-CONTROLLER] UUID: %s\n", uuid)
 
-	// 🔄 Step 2: Panggil service untuk hapus data berdasarkan UUID
-	err := f.service.GetFieldSchedule().Delete(c, uuid)
+	// 🧲 Step 2: Binding query parameter (force, reason, changedBy)
+	var params dto.DeleteFieldScheduleRequest
+	err := c.ShouldBindQuery(&params)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Gagal binding query params: %v\n", err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
 
-	// 🛑 Step 3: Cek jika ada error saat hapus data dari service
+	// ✅ Step 3: Validasi query parameter (reason dan changedBy wajib kalau force=true)
+	validate := validator.New()
+	err = validate.Struct(params)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Validasi gagal: %v\n", err)
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errValidation.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code:    http.StatusBadRequest,
+			Err:     err,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Gin:     c,
+		})
+		return
+	}
+
+	// 🔄 Step 4: Panggil service untuk hapus data berdasarkan UUID
+	err = f.service.GetFieldSchedule().Delete(c, uuid, &params)
+
+	// 🛑 Step 5: Cek jika ada error saat hapus data dari service
 	if err != nil {
 		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Gagal hapus data field schedule: %v\n", err)
 		response.HttpResponse(response.ParamHttpResp{
@@ -433,10 +895,136 @@ func (f *FieldScheduleController) Delete(c *gin.Context) {
 		return
 	}
 
-	// ✅ Step 4: Jika tidak ada error, kirimkan response sukses
+	// ✅ Step 6: Jika tidak ada error, kirimkan response sukses
 	fmt.Printf("✅ [INFO-FIELDSCHEDULE-CONTROLLER] Berhasil hapus data field schedule\n")
 	response.HttpResponse(response.ParamHttpResp{
 		Code: http.StatusOK,
 		Gin:  c,
 	})
 }
+
+func (f *FieldScheduleController) GetEvents(c *gin.Context) {
+	// 🚀 Step 1: Binding query parameter (afterID, limit)
+	var params dto.FieldScheduleEventRequestParam
+	err := c.ShouldBindQuery(&params)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Gagal binding query params: %v\n", err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 2: Validasi query parameter
+	validate := validator.New()
+	err = validate.Struct(params)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Validasi gagal: %v\n", err)
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errValidation.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code:    http.StatusBadRequest,
+			Err:     err,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Gin:     c,
+		})
+		return
+	}
+
+	// 🔄 Step 3: Ambil event perubahan booking
+	result, err := f.service.GetFieldSchedule().GetEvents(c, &params)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Gagal ambil field schedule event: %v\n", err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 4: Kirim response sukses
+	response.HttpResponse(response.ParamHttpResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  c,
+	})
+}
+
+func (f *FieldScheduleController) GetAllDeletedWithPagination(c *gin.Context) {
+	// 🚀 Step 1: Binding query parameter (page, limit, filter, sort)
+	var params dto.FieldScheduleRequestParam
+	err := c.ShouldBindQuery(&params)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Gagal binding query params: %v\n", err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 2: Validasi query parameter
+	validate := validator.New()
+	err = validate.Struct(params)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Validasi gagal: %v\n", err)
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errValidation.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code:    http.StatusBadRequest,
+			Err:     err,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Gin:     c,
+		})
+		return
+	}
+
+	// 🔄 Step 3: Ambil jadwal yang sudah dihapus (trash)
+	result, err := f.service.GetFieldSchedule().GetAllDeletedWithPagination(c, &params)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Gagal ambil data field schedule terhapus: %v\n", err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 4: Kirim response sukses
+	response.HttpResponse(response.ParamHttpResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  c,
+	})
+}
+
+func (f *FieldScheduleController) Restore(c *gin.Context) {
+	// 🚀 Step 1: Ambil UUID dari URL
+	uuid := c.Param("uuid")
+
+	// 📞 Step 2: Restore jadwal yang sudah dihapus
+	result, err := f.service.GetFieldSchedule().Restore(c, uuid)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-FIELDSCHEDULE-CONTROLLER] Gagal restore field schedule (UUID: %s): %v\n", uuid, err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 3: Kirim response sukses
+	response.HttpResponse(response.ParamHttpResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  c,
+	})
+}
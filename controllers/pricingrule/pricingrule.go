@@ -0,0 +1,196 @@
+package controllers
+
+import (
+	errValidation "field-service/common/error"
+	"field-service/common/response"
+	"field-service/domain/dto"
+	"field-service/services"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type PricingRuleController struct {
+	service services.IServiceRegistry
+}
+
+type IPricingRuleController interface {
+	GetAll(*gin.Context)
+	GetByUUID(*gin.Context)
+	Create(*gin.Context)
+	Update(*gin.Context)
+	Delete(*gin.Context)
+}
+
+func NewPricingRuleController(service services.IServiceRegistry) IPricingRuleController {
+	return &PricingRuleController{service: service}
+}
+
+func (p *PricingRuleController) GetAll(c *gin.Context) {
+	// 🚀 Step 1: Ambil semua data pricing rule dari service
+	result, err := p.service.GetPricingRule().GetAll(c)
+	if err != nil {
+		// 🛑 Step 2: Jika ada error, kirim response error
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 3: Kirim response sukses
+	response.HttpResponse(response.ParamHttpResp{
+		Code: http.StatusOK,
+		Gin:  c,
+		Data: result,
+	})
+}
+
+func (p *PricingRuleController) GetByUUID(c *gin.Context) {
+	// 🚀 Step 1: Ambil UUID dari parameter URL
+	uuid := c.Param("uuid")
+
+	// 🚀 Step 2: Ambil data pricing rule berdasarkan UUID dari service
+	result, err := p.service.GetPricingRule().GetByUUID(c, uuid)
+	if err != nil {
+		// 🛑 Step 3: Jika ada error, kirim response error
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 4: Kirim response sukses
+	response.HttpResponse(response.ParamHttpResp{
+		Code: http.StatusOK,
+		Gin:  c,
+		Data: result,
+	})
+}
+
+func (p *PricingRuleController) Create(c *gin.Context) {
+	// 🧾 Step 1: Bind body JSON ke struct request
+	var request dto.PricingRuleRequest
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// 📜 Step 2: Validasi input
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		fmt.Println("❌ [ERROR-PRICING-RULE-CONTROLLER] Gagal validasi input:", err)
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errValidation.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code:    http.StatusBadRequest,
+			Err:     err,
+			Message: &errMessage,
+			Data:    errResponse,
+			Gin:     c,
+		})
+		return
+	}
+
+	// 🚀 Step 3: Buat pricing rule baru
+	result, err := p.service.GetPricingRule().Create(c, &request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 4: Kirim response sukses
+	response.HttpResponse(response.ParamHttpResp{
+		Code: http.StatusCreated,
+		Gin:  c,
+		Data: result,
+	})
+}
+
+func (p *PricingRuleController) Update(c *gin.Context) {
+	// 🧾 Step 1: Bind body JSON ke struct request
+	var request dto.PricingRuleRequest
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// 📜 Step 2: Validasi input
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		fmt.Println("❌ [ERROR-PRICING-RULE-CONTROLLER] Gagal validasi input:", err)
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errValidation.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code:    http.StatusBadRequest,
+			Err:     err,
+			Message: &errMessage,
+			Data:    errResponse,
+			Gin:     c,
+		})
+		return
+	}
+
+	// 🚀 Step 3: Update pricing rule berdasarkan UUID di URL
+	result, err := p.service.GetPricingRule().Update(c, c.Param("uuid"), &request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 4: Kirim response sukses
+	response.HttpResponse(response.ParamHttpResp{
+		Code: http.StatusOK,
+		Gin:  c,
+		Data: result,
+	})
+}
+
+func (p *PricingRuleController) Delete(c *gin.Context) {
+	// 🚀 Step 1: Ambil parameter UUID dari URL
+	uuid := c.Param("uuid")
+
+	// 📞 Step 2: Hapus pricing rule
+	err := p.service.GetPricingRule().Delete(c, uuid)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-PRICING-RULE-CONTROLLER] Gagal hapus pricing rule (UUID: %s): %v\n", uuid, err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 3: Kirim response sukses
+	response.HttpResponse(response.ParamHttpResp{
+		Code: http.StatusOK,
+		Data: "Data pricing rule berhasil dihapus",
+		Gin:  c,
+	})
+}
@@ -1,9 +1,12 @@
 package controllers
 
 import (
+	closureController "field-service/controllers/closure"
 	controllers "field-service/controllers/field"
 	fieldScheduleController "field-service/controllers/fieldschedule"
+	pricingRuleController "field-service/controllers/pricingrule"
 	timeController "field-service/controllers/time"
+	venueController "field-service/controllers/venue"
 	"field-service/services"
 )
 
@@ -15,6 +18,9 @@ type IControllerRegistry interface {
 	GetField() controllers.IFieldController
 	GetFieldSchedule() fieldScheduleController.IFieldScheduleController
 	GetTime() timeController.ITimeController
+	GetClosure() closureController.IClosureController
+	GetPricingRule() pricingRuleController.IPricingRuleController
+	GetVenue() venueController.IVenueController
 }
 
 func NewControllerRegistry(service services.IServiceRegistry) IControllerRegistry {
@@ -32,3 +38,15 @@ func (r *Registry) GetFieldSchedule() fieldScheduleController.IFieldScheduleCont
 func (r *Registry) GetTime() timeController.ITimeController {
 	return timeController.NewTimeController(r.service)
 }
+
+func (r *Registry) GetClosure() closureController.IClosureController {
+	return closureController.NewClosureController(r.service)
+}
+
+func (r *Registry) GetPricingRule() pricingRuleController.IPricingRuleController {
+	return pricingRuleController.NewPricingRuleController(r.service)
+}
+
+func (r *Registry) GetVenue() venueController.IVenueController {
+	return venueController.NewVenueController(r.service)
+}
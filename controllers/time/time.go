@@ -20,6 +20,8 @@ type ITimeController interface {
 	GetAll(*gin.Context)
 	GetByUUID(*gin.Context)
 	Create(*gin.Context)
+	Update(*gin.Context)
+	Delete(*gin.Context)
 }
 
 func NewTimeController(service services.IServiceRegistry) ITimeController {
@@ -28,7 +30,8 @@ func NewTimeController(service services.IServiceRegistry) ITimeController {
 
 func (t *TimeController) GetAll(c *gin.Context) {
 	// 🚀 Step 1: Ambil semua data waktu dari service
-	result, err := t.service.GetTime().GetAll(c)
+	// Query ?fieldID=<uuid> (opsional) untuk mengambil katalog waktu milik field tertentu
+	result, err := t.service.GetTime().GetAll(c, c.Query("fieldID"))
 	if err != nil {
 		// 🛑 Step 2: Jika ada error, kirim response error
 		response.HttpResponse(response.ParamHttpResp{
@@ -122,3 +125,80 @@ func (t *TimeController) Create(c *gin.Context) {
 		Data: result,
 	})
 }
+
+func (t *TimeController) Update(c *gin.Context) {
+	// 🧾 Step 1: Ambil input dari body JSON
+	var request dto.TimeRequest
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		// 🛑 Step 2: Jika ada error saat binding, kirim response error
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// 📜 Step 3: Validasi input menggunakan validator
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		// 🛑 Step 4: Jika ada error saat validasi, kirim response error
+		fmt.Println("❌ [ERROR-TIME-CONTROLLER] Gagal validasi input:", err)
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errValidation.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code:    http.StatusBadRequest,
+			Err:     err,
+			Message: &errMessage,
+			Data:    errResponse, // Detail kesalahan input
+			Gin:     c,
+		})
+		return
+	}
+
+	// 🚀 Step 5: Kirim request ke service untuk memperbarui data waktu
+	result, err := t.service.GetTime().Update(c, c.Param("uuid"), &request)
+	if err != nil {
+		// 🛑 Step 6: Jika ada error, kirim response error
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 7: Jika data waktu berhasil diperbarui, kirim response sukses
+	response.HttpResponse(response.ParamHttpResp{
+		Code: http.StatusOK,
+		Gin:  c,
+		Data: result,
+	})
+}
+
+func (t *TimeController) Delete(c *gin.Context) {
+	// 🚀 Step 1: Ambil UUID dari parameter URL
+	uuid := c.Param("uuid")
+
+	// 🚀 Step 2: Hapus data waktu lewat service
+	err := t.service.GetTime().Delete(c, uuid)
+	if err != nil {
+		// 🛑 Step 3: Jika ada error, kirim response error
+		fmt.Printf("❌ [ERROR-TIME-CONTROLLER] Gagal hapus data waktu (UUID: %s): %v\n", uuid, err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 4: Jika berhasil, kirim response sukses
+	response.HttpResponse(response.ParamHttpResp{
+		Code: http.StatusOK,
+		Data: "Data waktu berhasil dihapus",
+		Gin:  c,
+	})
+}
@@ -0,0 +1,196 @@
+package controllers
+
+import (
+	errValidation "field-service/common/error"
+	"field-service/common/response"
+	"field-service/domain/dto"
+	"field-service/services"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type VenueController struct {
+	service services.IServiceRegistry
+}
+
+type IVenueController interface {
+	GetAll(*gin.Context)
+	GetByUUID(*gin.Context)
+	Create(*gin.Context)
+	Update(*gin.Context)
+	Delete(*gin.Context)
+}
+
+func NewVenueController(service services.IServiceRegistry) IVenueController {
+	return &VenueController{service: service}
+}
+
+func (v *VenueController) GetAll(c *gin.Context) {
+	// 🚀 Step 1: Ambil semua data venue dari service
+	result, err := v.service.GetVenue().GetAll(c)
+	if err != nil {
+		// 🛑 Step 2: Jika ada error, kirim response error
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 3: Kirim response sukses
+	response.HttpResponse(response.ParamHttpResp{
+		Code: http.StatusOK,
+		Gin:  c,
+		Data: result,
+	})
+}
+
+func (v *VenueController) GetByUUID(c *gin.Context) {
+	// 🚀 Step 1: Ambil UUID dari parameter URL
+	uuid := c.Param("uuid")
+
+	// 🚀 Step 2: Ambil data venue berdasarkan UUID dari service
+	result, err := v.service.GetVenue().GetByUUID(c, uuid)
+	if err != nil {
+		// 🛑 Step 3: Jika ada error, kirim response error
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 4: Kirim response sukses
+	response.HttpResponse(response.ParamHttpResp{
+		Code: http.StatusOK,
+		Gin:  c,
+		Data: result,
+	})
+}
+
+func (v *VenueController) Create(c *gin.Context) {
+	// 🧾 Step 1: Bind body JSON ke struct request
+	var request dto.VenueRequest
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// 📜 Step 2: Validasi input
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		fmt.Println("❌ [ERROR-VENUE-CONTROLLER] Gagal validasi input:", err)
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errValidation.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code:    http.StatusBadRequest,
+			Err:     err,
+			Message: &errMessage,
+			Data:    errResponse,
+			Gin:     c,
+		})
+		return
+	}
+
+	// 🚀 Step 3: Buat venue baru
+	result, err := v.service.GetVenue().Create(c, &request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 4: Kirim response sukses
+	response.HttpResponse(response.ParamHttpResp{
+		Code: http.StatusCreated,
+		Gin:  c,
+		Data: result,
+	})
+}
+
+func (v *VenueController) Update(c *gin.Context) {
+	// 🧾 Step 1: Bind body JSON ke struct request
+	var request dto.VenueRequest
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// 📜 Step 2: Validasi input
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		fmt.Println("❌ [ERROR-VENUE-CONTROLLER] Gagal validasi input:", err)
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errValidation.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code:    http.StatusBadRequest,
+			Err:     err,
+			Message: &errMessage,
+			Data:    errResponse,
+			Gin:     c,
+		})
+		return
+	}
+
+	// 🚀 Step 3: Update venue berdasarkan UUID di URL
+	result, err := v.service.GetVenue().Update(c, c.Param("uuid"), &request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 4: Kirim response sukses
+	response.HttpResponse(response.ParamHttpResp{
+		Code: http.StatusOK,
+		Gin:  c,
+		Data: result,
+	})
+}
+
+func (v *VenueController) Delete(c *gin.Context) {
+	// 🚀 Step 1: Ambil parameter UUID dari URL
+	uuid := c.Param("uuid")
+
+	// 📞 Step 2: Hapus venue
+	err := v.service.GetVenue().Delete(c, uuid)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-VENUE-CONTROLLER] Gagal hapus venue (UUID: %s): %v\n", uuid, err)
+		response.HttpResponse(response.ParamHttpResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	// ✅ Step 3: Kirim response sukses
+	response.HttpResponse(response.ParamHttpResp{
+		Code: http.StatusOK,
+		Data: "Data venue berhasil dihapus",
+		Gin:  c,
+	})
+}
@@ -0,0 +1,26 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ClosureRequest struct {
+	Reason    string   `json:"reason" validate:"required"`
+	StartDate string   `json:"startDate" validate:"required"`
+	EndDate   string   `json:"endDate" validate:"required"`
+	FieldIDs  []string `json:"fieldIDs" validate:"dive,uuid"`
+}
+
+type ClosureResponse struct {
+	UUID             uuid.UUID  `json:"uuid"`
+	Reason           string     `json:"reason"`
+	StartDate        string     `json:"startDate"`
+	EndDate          string     `json:"endDate"`
+	FieldIDs         []string   `json:"fieldIDs"`
+	BlockedSchedules int64      `json:"blockedSchedules,omitempty"`
+	FlaggedBookings  int64      `json:"flaggedBookings,omitempty"`
+	CreatedAt        *time.Time `json:"createdAt"`
+	UpdatedAt        *time.Time `json:"updatedAt"`
+}
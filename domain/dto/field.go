@@ -8,27 +8,46 @@ import (
 )
 
 type FieldRequest struct {
+	VenueID      string                 `form:"venueID" validate:"omitempty,uuid"`
 	Name         string                 `form:"name" validate:"required"`
 	Code         string                 `form:"code" validate:"required"`
 	PricePerHour int                    `form:"pricePerHour" validate:"required"`
 	Images       []multipart.FileHeader `form:"images" validate:"required"`
+	SportType    string                 `form:"sportType" validate:"omitempty,oneof=futsal mini_soccer badminton basketball volleyball tennis padel"`
+	Surface      string                 `form:"surface" validate:"omitempty,oneof=synthetic_grass natural_grass vinyl parquet interlock cement hard_court"`
+	IsIndoor     bool                   `form:"isIndoor"`
+	Capacity     int                    `form:"capacity" validate:"omitempty,min=1"`
+	Amenities    []string               `form:"amenities" validate:"omitempty,unique,dive,oneof=parking shower locker toilet canteen musholla wifi lighting tribune equipment_rental"`
 }
 
 type UpdateFieldRequest struct {
+	VenueID      string                 `form:"venueID" validate:"omitempty,uuid"`
 	Name         string                 `form:"name" validate:"required"`
 	Code         string                 `form:"code" validate:"required"`
 	PricePerHour int                    `form:"pricePerHour" validate:"required"`
 	Images       []multipart.FileHeader `form:"images"`
+	SportType    string                 `form:"sportType" validate:"omitempty,oneof=futsal mini_soccer badminton basketball volleyball tennis padel"`
+	Surface      string                 `form:"surface" validate:"omitempty,oneof=synthetic_grass natural_grass vinyl parquet interlock cement hard_court"`
+	IsIndoor     bool                   `form:"isIndoor"`
+	Capacity     int                    `form:"capacity" validate:"omitempty,min=1"`
+	Amenities    []string               `form:"amenities" validate:"omitempty,unique,dive,oneof=parking shower locker toilet canteen musholla wifi lighting tribune equipment_rental"`
 }
 
 type FieldResponse struct {
-	UUID         uuid.UUID  `json:"uuid"`
-	Code         string     `json:"code"`
-	Name         string     `json:"name"`
-	PricePerHour int        `json:"pricePerHour"`
-	Images       []string   `json:"images"`
-	CreatedAt    *time.Time `json:"createdAt"`
-	UpdatedAt    *time.Time `json:"updatedAt"`
+	UUID         uuid.UUID           `json:"uuid"`
+	Code         string              `json:"code"`
+	Name         string              `json:"name"`
+	PricePerHour int                 `json:"pricePerHour"`
+	Images       []string            `json:"images"`
+	SportType    string              `json:"sportType"`
+	Surface      string              `json:"surface"`
+	IsIndoor     bool                `json:"isIndoor"`
+	Capacity     int                 `json:"capacity"`
+	Amenities    []string            `json:"amenities"`
+	Venue        *FieldVenueResponse `json:"venue"`
+	CreatedAt    *time.Time          `json:"createdAt"`
+	UpdatedAt    *time.Time          `json:"updatedAt"`
+	DeletedAt    *time.Time          `json:"deletedAt,omitempty"`
 }
 
 type FieldDetailResponse struct {
@@ -40,9 +59,23 @@ type FieldDetailResponse struct {
 	UpdatedAt    *time.Time `json:"updatedAt"`
 }
 
+// FieldRequestParam adalah query param list field. SortColumn hanya menerima key yang
+// didaftarkan di repository (name, code, pricePerHour, capacity, createdAt, updatedAt).
 type FieldRequestParam struct {
 	Page       int     `form:"page" validate:"required"`
 	Limit      int     `form:"limit" validate:"required"`
 	SortColumn *string `form:"sortColumn"`
-	SortOrder  *string `form:"sortOrder"`
+	SortOrder  *string `form:"sortOrder" validate:"omitempty,oneof=asc desc ASC DESC"`
+	FieldFilterParam
+}
+
+// FieldFilterParam berisi filter listing field (query param), dipakai dengan atau tanpa pagination.
+// Amenities diisi berulang (?amenities=parking&amenities=shower) dan field harus punya semuanya.
+type FieldFilterParam struct {
+	VenueID     *string  `form:"venueID" validate:"omitempty,uuid"`
+	SportType   *string  `form:"sportType" validate:"omitempty,oneof=futsal mini_soccer badminton basketball volleyball tennis padel"`
+	Surface     *string  `form:"surface" validate:"omitempty,oneof=synthetic_grass natural_grass vinyl parquet interlock cement hard_court"`
+	IsIndoor    *bool    `form:"isIndoor"`
+	MinCapacity *int     `form:"minCapacity" validate:"omitempty,min=1"`
+	Amenities   []string `form:"amenities" validate:"omitempty,dive,oneof=parking shower locker toilet canteen musholla wifi lighting tribune equipment_rental"`
 }
@@ -8,33 +8,153 @@ import (
 )
 
 type FieldScheduleRequest struct {
-	FieldID string   `json:"fieldId" validate:"required"`
-	Date    string   `json:"date" validate:"required"`
-	TimeIDs []string `json:"timeIDs" validate:"required"`
+	FieldID      string   `json:"fieldId" validate:"required"`
+	Date         string   `json:"date" validate:"required"`
+	TimeIDs      []string `json:"timeIDs" validate:"required,dive,uuid"`
+	SkipExisting bool     `json:"skipExisting"`
 }
 
 type GenerateFieldScheduleForOneMonthRequest struct {
-	FieldID string `json:"fieldId" validate:"required"`
+	FieldID      string `json:"fieldId" validate:"required"`
+	SkipExisting bool   `json:"skipExisting"`
 }
 
+type GenerateFieldScheduleRequest struct {
+	FieldID          string                   `json:"fieldId" validate:"required"`
+	StartDate        string                   `json:"startDate" validate:"required"`
+	EndDate          string                   `json:"endDate" validate:"required"`
+	WeekdayTemplates []WeekdayTemplateRequest `json:"weekdayTemplates" validate:"dive"`
+	SkipExisting     bool                     `json:"skipExisting"`
+}
+
+// WeekdayTemplateRequest menentukan time slot yang berlaku pada satu hari (0 = Minggu, 6 = Sabtu).
+// TimeIDs kosong artinya lapangan tutup di hari tersebut.
+type WeekdayTemplateRequest struct {
+	Weekday int      `json:"weekday" validate:"min=0,max=6"`
+	TimeIDs []string `json:"timeIDs" validate:"dive,uuid"`
+}
+
+// GenerateFieldScheduleResponse berisi laporan jumlah slot yang dibuat dan yang dilewati karena sudah ada.
+type GenerateFieldScheduleResponse struct {
+	Created int `json:"created"`
+	Skipped int `json:"skipped"`
+}
+
+// UpdateFieldScheduleRequest memindahkan jadwal ke tanggal/time slot lain, dan opsional ke field lain
+// (FieldID kosong artinya tetap di field yang sama). Jadwal yang sudah Booked hanya bisa dipindah
+// dengan Force=true (beserta Reason dan ChangedBy), dan akan memicu event BookingRescheduled.
 type UpdateFieldScheduleRequest struct {
-	Date   string `json:"date" validate:"required"`
-	TimeID string `json:"timeID" validate:"required"`
+	Date      string  `json:"date" validate:"required,datetime=2006-01-02"`
+	TimeID    string  `json:"timeID" validate:"required"`
+	FieldID   *string `json:"fieldID" validate:"omitempty,uuid"`
+	Force     bool    `json:"force"`
+	Reason    string  `json:"reason" validate:"required_if=Force true"`
+	ChangedBy string  `json:"changedBy" validate:"required_if=Force true"`
 }
 
+// DeleteFieldScheduleRequest adalah query param hapus jadwal. Jadwal yang sudah Booked hanya bisa
+// dihapus dengan force=true (beserta reason dan changedBy), dan akan memicu event BookingVoided.
+type DeleteFieldScheduleRequest struct {
+	Force     bool   `form:"force"`
+	Reason    string `form:"reason" validate:"required_if=Force true"`
+	ChangedBy string `form:"changedBy" validate:"required_if=Force true"`
+}
+
+// UpdateStatusFieldScheduleRequest membooking jadwal untuk UserID. Slot Held hanya bisa dibooking
+// oleh customer pemilik hold, kecuali hold-nya sudah expired.
 type UpdateStatusFieldScheduleRequest struct {
-	FieldScheduleIDs []string `json:"fieldScheduleIDs" validate:"required"`
+	FieldScheduleIDs []string `json:"fieldScheduleIDs" validate:"required,dive,uuid"`
+	UserID           string   `json:"userID" validate:"required,uuid"`
+}
+
+type ChangeStatusFieldScheduleRequest struct {
+	FieldScheduleIDs []string                          `json:"fieldScheduleIDs" validate:"required,dive,uuid"`
+	Status           constants.FieldScheduleStatusName `json:"status" validate:"required,oneof=Available Blocked Maintenance Cancelled"`
+}
+
+type ReleaseFieldScheduleRequest struct {
+	FieldScheduleIDs []string `json:"fieldScheduleIDs" validate:"required,dive,uuid"`
+	Reason           string   `json:"reason" validate:"required"`
+	ReleasedBy       string   `json:"releasedBy" validate:"required"`
+	// Status tujuan setelah dilepas, kosong artinya Available
+	Status constants.FieldScheduleStatusName `json:"status" validate:"omitempty,oneof=Available Blocked Cancelled"`
+}
+
+type HoldFieldScheduleRequest struct {
+	FieldScheduleIDs []string `json:"fieldScheduleIDs" validate:"required,dive,uuid"`
+	UserID           string   `json:"userID" validate:"required,uuid"`
+}
+
+type ReleaseHoldFieldScheduleRequest struct {
+	FieldScheduleIDs []string `json:"fieldScheduleIDs" validate:"required,dive,uuid"`
+	UserID           string   `json:"userID" validate:"required,uuid"`
+}
+
+type HoldFieldScheduleResponse struct {
+	FieldScheduleIDs []string  `json:"fieldScheduleIDs"`
+	HeldUntil        time.Time `json:"heldUntil"`
+}
+
+type BookRangeFieldScheduleRequest struct {
+	FieldID         string `json:"fieldID" validate:"required,uuid"`
+	Date            string `json:"date" validate:"required"`
+	StartTime       string `json:"startTime" validate:"required"`
+	DurationMinutes int    `json:"durationMinutes" validate:"required,min=1"`
+	UserID          string `json:"userID" validate:"required,uuid"`
+}
+
+type BookRangeFieldScheduleResponse struct {
+	FieldScheduleIDs []string                   `json:"fieldScheduleIDs"`
+	Date             string                     `json:"date"`
+	StartTime        string                     `json:"startTime"`
+	EndTime          string                     `json:"endTime"`
+	DurationMinutes  int                        `json:"durationMinutes"`
+	TotalPrice       int                        `json:"totalPrice"`
+	Slots            []BookedFieldScheduleSlots `json:"slots"`
+}
+
+type BookedFieldScheduleSlots struct {
+	UUID         uuid.UUID `json:"uuid"`
+	Time         string    `json:"time"`
+	PricePerHour int       `json:"pricePerHour"`
 }
 
 type FieldScheduleResponse struct {
-	UUID         uuid.UUID                         `json:"uuid"`
-	FieldName    string                            `json:"fieldName"`
-	PricePerHour int                               `json:"pricePerHour"`
-	Date         string                            `json:"date"`
-	Status       constants.FieldScheduleStatusName `json:"status"`
-	Time         string                            `json:"time"`
-	CreatedAt    *time.Time                        `json:"createdAt"`
-	UpdatedAt    *time.Time                        `json:"updatedAt"`
+	UUID          uuid.UUID                         `json:"uuid"`
+	FieldName     string                            `json:"fieldName"`
+	PricePerHour  int                               `json:"pricePerHour"`
+	Date          string                            `json:"date"`
+	Status        constants.FieldScheduleStatusName `json:"status"`
+	Time          string                            `json:"time"`
+	NeedsFollowUp bool                              `json:"needsFollowUp"`
+	Timezone      string                            `json:"timezone"`
+	CreatedAt     *time.Time                        `json:"createdAt"`
+	UpdatedAt     *time.Time                        `json:"updatedAt"`
+	DeletedAt     *time.Time                        `json:"deletedAt,omitempty"`
+}
+
+// FieldScheduleEventRequestParam adalah query param feed event. AfterID adalah ID event terakhir
+// yang sudah diproses consumer (0 untuk mulai dari awal).
+type FieldScheduleEventRequestParam struct {
+	AfterID uint `form:"afterID"`
+	Limit   int  `form:"limit" validate:"omitempty,min=1,max=500"`
+}
+
+type FieldScheduleEventResponse struct {
+	ID              uint                             `json:"id"`
+	UUID            uuid.UUID                        `json:"uuid"`
+	EventType       constants.FieldScheduleEventType `json:"eventType"`
+	FieldScheduleID uuid.UUID                        `json:"fieldScheduleID"`
+	FieldID         uuid.UUID                        `json:"fieldID"`
+	NewFieldID      *uuid.UUID                       `json:"newFieldID"`
+	PreviousDate    string                           `json:"previousDate"`
+	PreviousTime    string                           `json:"previousTime"`
+	NewDate         *string                          `json:"newDate"`
+	NewTime         *string                          `json:"newTime"`
+	PricePerHour    *int                             `json:"pricePerHour"`
+	Reason          string                           `json:"reason"`
+	ChangedBy       string                           `json:"changedBy"`
+	CreatedAt       *time.Time                       `json:"createdAt"`
 }
 
 type FieldScheduleForBookingResponse struct {
@@ -43,15 +163,70 @@ type FieldScheduleForBookingResponse struct {
 	Date         string                            `json:"date"`
 	Status       constants.FieldScheduleStatusName `json:"status"`
 	Time         string                            `json:"time"`
+	Timezone     string                            `json:"timezone"`
 }
 
+// FieldScheduleRequestParam adalah query param list jadwal. SortColumn hanya menerima key yang
+// didaftarkan di repository (date, status, createdAt, updatedAt); DateFrom/DateTo inklusif.
 type FieldScheduleRequestParam struct {
 	Page       int     `form:"page" validate:"required"`
 	Limit      int     `form:"limit" validate:"required"`
 	SortColumn *string `form:"sortColumn"`
-	SortOrder  *string `form:"sortOrder"`
+	SortOrder  *string `form:"sortOrder" validate:"omitempty,oneof=asc desc ASC DESC"`
+	FieldScheduleFilterParam
+}
+
+// FieldScheduleCursorRequestParam adalah query param list jadwal dengan keyset pagination
+// (urut created_at desc, id desc). Cursor diambil dari nextCursor response sebelumnya;
+// WithTotal=true menambahkan totalData (COUNT(*)), defaultnya tidak dihitung.
+type FieldScheduleCursorRequestParam struct {
+	Limit     int     `form:"limit" validate:"required,min=1,max=500"`
+	Cursor    *string `form:"cursor"`
+	WithTotal bool    `form:"withTotal"`
+	FieldScheduleFilterParam
+}
+
+// FieldScheduleFilterParam berisi filter list jadwal, dipakai pagination offset maupun cursor.
+type FieldScheduleFilterParam struct {
+	Status   *string `form:"status" validate:"omitempty,oneof=Available Held Booked Blocked Maintenance Cancelled"`
+	FieldID  *string `form:"fieldID" validate:"omitempty,uuid"`
+	DateFrom *string `form:"dateFrom" validate:"omitempty,datetime=2006-01-02"`
+	DateTo   *string `form:"dateTo" validate:"omitempty,datetime=2006-01-02"`
 }
 
 type FieldScheduleByFieldIDAndDateRequestParam struct {
-	Date string `form:"date" validate:"required"`
+	// Date kosong artinya hari ini menurut timezone venue field.
+	Date string `form:"date" validate:"omitempty,datetime=2006-01-02"`
+}
+
+// FieldAvailabilityRequestParam mencari field yang punya slot Available di Date dengan jam di dalam
+// rentang StartTime–EndTime. Harga difilter berdasarkan harga efektif per slot (setelah pricing rule).
+type FieldAvailabilityRequestParam struct {
+	Date      string  `form:"date" validate:"required,datetime=2006-01-02"`
+	StartTime string  `form:"startTime" validate:"required"`
+	EndTime   string  `form:"endTime" validate:"required"`
+	SportType *string `form:"sportType" validate:"omitempty,oneof=futsal mini_soccer badminton basketball volleyball tennis padel"`
+	VenueID   *string `form:"venueID" validate:"omitempty,uuid"`
+	MinPrice  *int    `form:"minPrice" validate:"omitempty,min=0"`
+	MaxPrice  *int    `form:"maxPrice" validate:"omitempty,min=0"`
+}
+
+type FieldAvailabilityResponse struct {
+	UUID         uuid.UUID                    `json:"uuid"`
+	Code         string                       `json:"code"`
+	Name         string                       `json:"name"`
+	SportType    string                       `json:"sportType"`
+	Surface      string                       `json:"surface"`
+	IsIndoor     bool                         `json:"isIndoor"`
+	PricePerHour int                          `json:"pricePerHour"`
+	Venue        *FieldVenueResponse          `json:"venue"`
+	Date         string                       `json:"date"`
+	Slots        []AvailableFieldScheduleSlot `json:"slots"`
+}
+
+type AvailableFieldScheduleSlot struct {
+	UUID         uuid.UUID `json:"uuid"`
+	StartTime    string    `json:"startTime"`
+	EndTime      string    `json:"endTime"`
+	PricePerHour int       `json:"pricePerHour"`
 }
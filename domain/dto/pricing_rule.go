@@ -0,0 +1,38 @@
+package dto
+
+import (
+	"field-service/constants"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PricingRuleRequest struct {
+	Name           string                          `json:"name" validate:"required"`
+	FieldID        string                          `json:"fieldID" validate:"omitempty,uuid"`
+	Weekdays       []int                           `json:"weekdays" validate:"dive,min=0,max=6"`
+	StartTime      string                          `json:"startTime"`
+	EndTime        string                          `json:"endTime"`
+	Date           string                          `json:"date"`
+	AdjustmentType constants.PricingAdjustmentType `json:"adjustmentType" validate:"required,oneof=Percentage Fixed Override"`
+	Value          int                             `json:"value"`
+	Priority       int                             `json:"priority"`
+	IsActive       *bool                           `json:"isActive"`
+}
+
+type PricingRuleResponse struct {
+	UUID           uuid.UUID                       `json:"uuid"`
+	Name           string                          `json:"name"`
+	FieldID        *uuid.UUID                      `json:"fieldID"`
+	FieldName      string                          `json:"fieldName,omitempty"`
+	Weekdays       []int64                         `json:"weekdays"`
+	StartTime      *string                         `json:"startTime"`
+	EndTime        *string                         `json:"endTime"`
+	Date           *string                         `json:"date"`
+	AdjustmentType constants.PricingAdjustmentType `json:"adjustmentType"`
+	Value          int                             `json:"value"`
+	Priority       int                             `json:"priority"`
+	IsActive       bool                            `json:"isActive"`
+	CreatedAt      *time.Time                      `json:"createdAt"`
+	UpdatedAt      *time.Time                      `json:"updatedAt"`
+}
@@ -7,14 +7,17 @@ import (
 )
 
 type TimeRequest struct {
+	FieldID   string `json:"fieldID" validate:"omitempty,uuid"`
 	StartTime string `json:"startTime" validate:"required"`
 	EndTime   string `json:"endTime" validate:"required"`
 }
 
 type TimeResponse struct {
-	UUID      uuid.UUID  `json:"uuid"`
-	StartTime string     `json:"startTime"`
-	EndTime   string     `json:"endtime"`
-	CreatedAt *time.Time `json:"createdAt"`
-	UpdatedAt *time.Time `json:"updatedAt"`
+	UUID            uuid.UUID  `json:"uuid"`
+	FieldID         *uuid.UUID `json:"fieldID"`
+	StartTime       string     `json:"startTime"`
+	EndTime         string     `json:"endtime"`
+	DurationMinutes int        `json:"durationMinutes"`
+	CreatedAt       *time.Time `json:"createdAt"`
+	UpdatedAt       *time.Time `json:"updatedAt"`
 }
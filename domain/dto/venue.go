@@ -0,0 +1,40 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type VenueRequest struct {
+	Name         string  `json:"name" validate:"required"`
+	Address      string  `json:"address" validate:"required"`
+	Latitude     float64 `json:"latitude" validate:"latitude"`
+	Longitude    float64 `json:"longitude" validate:"longitude"`
+	Timezone     string  `json:"timezone"`
+	OpenTime     string  `json:"openTime" validate:"required"`
+	CloseTime    string  `json:"closeTime" validate:"required"`
+	ContactPhone string  `json:"contactPhone"`
+	ContactEmail string  `json:"contactEmail" validate:"omitempty,email"`
+}
+
+type VenueResponse struct {
+	UUID         uuid.UUID  `json:"uuid"`
+	Name         string     `json:"name"`
+	Address      string     `json:"address"`
+	Latitude     float64    `json:"latitude"`
+	Longitude    float64    `json:"longitude"`
+	Timezone     string     `json:"timezone"`
+	OpenTime     string     `json:"openTime"`
+	CloseTime    string     `json:"closeTime"`
+	ContactPhone string     `json:"contactPhone"`
+	ContactEmail string     `json:"contactEmail"`
+	CreatedAt    *time.Time `json:"createdAt"`
+	UpdatedAt    *time.Time `json:"updatedAt"`
+}
+
+// FieldVenueResponse adalah ringkasan venue yang ikut di response field.
+type FieldVenueResponse struct {
+	UUID uuid.UUID `json:"uuid"`
+	Name string    `json:"name"`
+}
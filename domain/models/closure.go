@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// Closure adalah hari libur/penutupan venue. FieldIDs berisi UUID field yang ditutup,
+// kalau kosong artinya berlaku untuk semua field.
+type Closure struct {
+	ID        uint           `gorm:"primaryKey;autoIncrement"`
+	UUID      uuid.UUID      `gorm:"type:uuid;not null"`
+	Reason    string         `gorm:"type:varchar(255);not null"`
+	StartDate time.Time      `gorm:"type:date;not null;index"`
+	EndDate   time.Time      `gorm:"type:date;not null;index"`
+	FieldIDs  pq.StringArray `gorm:"type:text[];not null;default:'{}'"`
+	CreatedAt *time.Time
+	UpdatedAt *time.Time
+}
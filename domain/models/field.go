@@ -8,15 +8,25 @@ import (
 	"gorm.io/gorm"
 )
 
+// Field adalah satu lapangan. SportType, Surface, IsIndoor, Capacity dan Amenities
+// dipakai untuk filter pencarian di listing field (lihat dto.FieldFilterParam).
+// Delete bersifat soft delete (DeletedAt), jadi riwayat jadwal & booking tidak ikut terhapus cascade.
 type Field struct {
 	ID            uint           `gorm:"primaryKey;autoIncrement"`
 	UUID          uuid.UUID      `gorm:"type:uuid;not null"`
+	VenueID       *uint          `gorm:"type:int;index"`
 	Code          string         `gorm:"type:varchar(15);not null"`
 	Name          string         `gorm:"type:varchar(100);not null"`
 	PricePerHour  int            `gorm:"type:int;not null"`
 	Images        pq.StringArray `gorm:"type:text[];not null"`
+	SportType     string         `gorm:"type:varchar(30);not null;default:'';index"`
+	Surface       string         `gorm:"type:varchar(30);not null;default:''"`
+	IsIndoor      bool           `gorm:"not null;default:false"`
+	Capacity      int            `gorm:"type:int;not null;default:0"`
+	Amenities     pq.StringArray `gorm:"type:text[];not null;default:'{}'"`
 	CreatedAt     *time.Time
 	UpdatedAt     *time.Time
-	DeletedAt     *gorm.DeletedAt
+	DeletedAt     gorm.DeletedAt  `gorm:"index"`
 	FieldSchedule []FieldSchedule `gorm:"foreignKey:field_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Venue         *Venue
 }
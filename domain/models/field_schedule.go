@@ -5,18 +5,31 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
+// FieldSchedule adalah slot jadwal satu field pada satu tanggal dan time slot.
+// ClosureID terisi jika slot diblokir/ditandai oleh closure (hari libur/penutupan).
+// PricePerHour adalah snapshot harga efektif saat slot dibooking, supaya perubahan harga
+// field atau pricing rule setelahnya tidak mengubah harga booking yang sudah terjadi.
+// Index (created_at, id) dipakai keyset pagination di list jadwal.
+// DeletedAt adalah soft delete; unique (field, date, time) hanya berlaku untuk slot yang belum dihapus,
+// supaya slot yang sudah dihapus tidak menghalangi generate ulang.
 type FieldSchedule struct {
-	ID        uint                          `gorm:"primaryKey;autoIncrement"`
-	UUID      uuid.UUID                     `gorm:"type:uuid;not null"`
-	FieldID   uint                          `gorm:"type:int;not null"`
-	TimeID    uint                          `gorm:"type:int;not null"`
-	Date      time.Time                     `gorm:"type:date;not null"`
-	Status    constants.FieldScheduleStatus `gorm:"type:int;not null"`
-	CreatedAt *time.Time
-	UpdatedAt *time.Time
-	DeletedAt *time.Time
-	Field     Field `gorm:"foreignKey:field_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
-	Time      Time  `gorm:"foreignKey:time_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	ID            uint                          `gorm:"primaryKey;autoIncrement;index:idx_field_schedules_created_at_id,priority:2"`
+	UUID          uuid.UUID                     `gorm:"type:uuid;not null"`
+	FieldID       uint                          `gorm:"type:int;not null;uniqueIndex:idx_field_schedules_active_field_date_time,priority:1,where:deleted_at IS NULL"`
+	TimeID        uint                          `gorm:"type:int;not null;uniqueIndex:idx_field_schedules_active_field_date_time,priority:3"`
+	Date          time.Time                     `gorm:"type:date;not null;uniqueIndex:idx_field_schedules_active_field_date_time,priority:2"`
+	Status        constants.FieldScheduleStatus `gorm:"type:int;not null"`
+	HeldBy        *uuid.UUID                    `gorm:"type:uuid"`
+	HeldUntil     *time.Time                    `gorm:"index"`
+	ClosureID     *uint                         `gorm:"type:int;index"`
+	NeedsFollowUp bool                          `gorm:"not null;default:false"`
+	PricePerHour  *int                          `gorm:"type:int"`
+	CreatedAt     *time.Time                    `gorm:"index:idx_field_schedules_created_at_id,priority:1"`
+	UpdatedAt     *time.Time
+	DeletedAt     gorm.DeletedAt `gorm:"index"`
+	Field         Field          `gorm:"foreignKey:field_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Time          Time           `gorm:"foreignKey:time_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
 }
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"field-service/constants"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FieldScheduleEvent adalah outbox event untuk perubahan paksa pada slot yang sudah dibooking.
+// Ditulis dalam transaksi yang sama dengan perubahannya, lalu dibaca service order/notifikasi
+// lewat feed event (urut ID). Datanya disalin (bukan FK) supaya tetap utuh walau slotnya dihapus.
+type FieldScheduleEvent struct {
+	ID                uint                             `gorm:"primaryKey;autoIncrement"`
+	UUID              uuid.UUID                        `gorm:"type:uuid;not null"`
+	EventType         constants.FieldScheduleEventType `gorm:"type:varchar(50);not null"`
+	FieldScheduleUUID uuid.UUID                        `gorm:"type:uuid;not null;index"`
+	FieldUUID         uuid.UUID                        `gorm:"type:uuid;not null"`
+	NewFieldUUID      *uuid.UUID                       `gorm:"type:uuid"`
+	PreviousDate      time.Time                        `gorm:"type:date;not null"`
+	PreviousTime      string                           `gorm:"type:varchar(30);not null"`
+	NewDate           *time.Time                       `gorm:"type:date"`
+	NewTime           *string                          `gorm:"type:varchar(30)"`
+	PricePerHour      *int                             `gorm:"type:int"`
+	Reason            string                           `gorm:"type:text;not null"`
+	ChangedBy         string                           `gorm:"type:varchar(100);not null"`
+	CreatedAt         *time.Time
+}
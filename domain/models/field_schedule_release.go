@@ -0,0 +1,21 @@
+package models
+
+import (
+	"field-service/constants"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FieldScheduleRelease mencatat siapa yang melepas slot yang sudah dibooking dan alasannya.
+type FieldScheduleRelease struct {
+	ID              uint                          `gorm:"primaryKey;autoIncrement"`
+	UUID            uuid.UUID                     `gorm:"type:uuid;not null"`
+	FieldScheduleID uint                          `gorm:"type:int;not null;index"`
+	PreviousStatus  constants.FieldScheduleStatus `gorm:"type:int;not null"`
+	PricePerHour    *int                          `gorm:"type:int"`
+	Reason          string                        `gorm:"type:text;not null"`
+	ReleasedBy      string                        `gorm:"type:varchar(100);not null"`
+	CreatedAt       *time.Time
+	FieldSchedule   FieldSchedule `gorm:"foreignKey:field_schedule_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
@@ -0,0 +1,30 @@
+package models
+
+import (
+	"field-service/constants"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// PricingRule adalah aturan harga dinamis (jam sibuk, weekend, tanggal khusus).
+// Kondisi yang kosong dianggap cocok untuk semua: FieldID nil = semua field,
+// Weekdays kosong = semua hari, StartTime/EndTime nil = sepanjang hari, Date nil = semua tanggal.
+type PricingRule struct {
+	ID             uint                            `gorm:"primaryKey;autoIncrement"`
+	UUID           uuid.UUID                       `gorm:"type:uuid;not null"`
+	Name           string                          `gorm:"type:varchar(100);not null"`
+	FieldID        *uint                           `gorm:"type:int;index"`
+	Weekdays       pq.Int64Array                   `gorm:"type:integer[];not null;default:'{}'"`
+	StartTime      *string                         `gorm:"type:time without time zone"`
+	EndTime        *string                         `gorm:"type:time without time zone"`
+	Date           *time.Time                      `gorm:"type:date;index"`
+	AdjustmentType constants.PricingAdjustmentType `gorm:"type:varchar(20);not null"`
+	Value          int                             `gorm:"type:int;not null"`
+	Priority       int                             `gorm:"type:int;not null;default:0"`
+	IsActive       bool                            `gorm:"not null;default:true"`
+	CreatedAt      *time.Time
+	UpdatedAt      *time.Time
+	Field          *Field `gorm:"foreignKey:field_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
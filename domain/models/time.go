@@ -6,11 +6,16 @@ import (
 	"github.com/google/uuid"
 )
 
+// Time adalah satu time slot di katalog waktu. FieldID nil artinya slot global; kalau terisi,
+// slot hanya milik field tersebut. Field yang punya slot sendiri memakai katalognya sendiri
+// (override), field lain memakai katalog global.
 type Time struct {
 	ID        uint      `gorm:"primaryKey;autoIncrement"`
 	UUID      uuid.UUID `gorm:"type:uuid;not null"`
+	FieldID   *uint     `gorm:"type:int;index"`
 	StartTime string    `gorm:"type:time without time zone;not null"`
 	EndTime   string    `gorm:"type:time without time zone;not null"`
 	CreatedAt *time.Time
 	UpdatedAt *time.Time
+	Field     *Field `gorm:"foreignKey:field_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 }
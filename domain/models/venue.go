@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Venue adalah lokasi/gedung yang menaungi beberapa field (lapangan).
+type Venue struct {
+	ID           uint      `gorm:"primaryKey;autoIncrement"`
+	UUID         uuid.UUID `gorm:"type:uuid;not null"`
+	Name         string    `gorm:"type:varchar(100);not null"`
+	Address      string    `gorm:"type:text;not null"`
+	Latitude     float64   `gorm:"type:double precision;not null"`
+	Longitude    float64   `gorm:"type:double precision;not null"`
+	Timezone     string    `gorm:"type:varchar(50);not null;default:'Asia/Jakarta'"`
+	OpenTime     string    `gorm:"type:time without time zone;not null"`
+	CloseTime    string    `gorm:"type:time without time zone;not null"`
+	ContactPhone string    `gorm:"type:varchar(20)"`
+	ContactEmail string    `gorm:"type:varchar(100)"`
+	CreatedAt    *time.Time
+	UpdatedAt    *time.Time
+	Fields       []Field `gorm:"foreignKey:venue_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+}
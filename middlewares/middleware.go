@@ -22,6 +22,7 @@ import (
 	"github.com/didip/tollbooth/limiter"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 )
 
@@ -59,6 +60,27 @@ func RateLimiter(lmt *limiter.Limiter) gin.HandlerFunc {
 	}
 }
 
+// ValidateUUIDParam menolak request dengan path param :uuid yang bukan UUID valid (400),
+// supaya nilai asal-asalan tidak sampai ke query database dan berakhir jadi error SQL.
+func ValidateUUIDParam() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		param := c.Param("uuid")
+		if param != "" {
+			_, err := uuid.Parse(param)
+			if err != nil {
+				fmt.Println("❌ [MIDDLEWARE-ERROR] Path param uuid tidak valid:", param)
+				c.JSON(http.StatusBadRequest, response.Response{
+					Status:  constants.Error,
+					Message: errConstant.ErrInvalidUUID.Error(),
+				})
+				c.Abort()
+				return
+			}
+		}
+		c.Next()
+	}
+}
+
 func extractBearerToken(token string) string {
 	arrayToken := strings.Split(token, " ")
 	if len(arrayToken) == 2 {
@@ -0,0 +1,6 @@
+-- Baseline tidak bisa di-rollback: menghapus tabel berarti menghapus semua data booking.
+-- Kalau memang perlu mengosongkan database, lakukan manual di luar migrate.
+DO $$
+BEGIN
+    RAISE EXCEPTION 'migration 000001_init_schema adalah baseline dan tidak bisa di-rollback';
+END $$;
@@ -0,0 +1,210 @@
+-- Baseline schema, sama dengan hasil AutoMigrate terakhir sebelum migration berversi dipakai.
+-- Database lama yang dibuat AutoMigrate versi awal (fields, field_schedules, times saja) juga dibawa ke
+-- schema ini: tabel baru dibuat dengan IF NOT EXISTS, kolom yang ditambahkan setelah versi awal
+-- ditambah lewat ALTER TABLE ... ADD COLUMN IF NOT EXISTS, dan foreign key baru hanya dibuat kalau belum ada.
+
+CREATE TABLE IF NOT EXISTS venues (
+    id            bigserial PRIMARY KEY,
+    uuid          uuid NOT NULL,
+    name          varchar(100) NOT NULL,
+    address       text NOT NULL,
+    latitude      double precision NOT NULL,
+    longitude     double precision NOT NULL,
+    timezone      varchar(50) NOT NULL DEFAULT 'Asia/Jakarta',
+    open_time     time without time zone NOT NULL,
+    close_time    time without time zone NOT NULL,
+    contact_phone varchar(20),
+    contact_email varchar(100),
+    created_at    timestamptz,
+    updated_at    timestamptz
+);
+
+CREATE TABLE IF NOT EXISTS fields (
+    id             bigserial PRIMARY KEY,
+    uuid           uuid NOT NULL,
+    venue_id       integer,
+    code           varchar(15) NOT NULL,
+    name           varchar(100) NOT NULL,
+    price_per_hour integer NOT NULL,
+    images         text[] NOT NULL,
+    sport_type     varchar(30) NOT NULL DEFAULT '',
+    surface        varchar(30) NOT NULL DEFAULT '',
+    is_indoor      boolean NOT NULL DEFAULT false,
+    capacity       integer NOT NULL DEFAULT 0,
+    amenities      text[] NOT NULL DEFAULT '{}',
+    created_at     timestamptz,
+    updated_at     timestamptz,
+    deleted_at     timestamptz,
+    CONSTRAINT fk_venues_fields FOREIGN KEY (venue_id) REFERENCES venues (id)
+        ON UPDATE CASCADE ON DELETE SET NULL
+);
+
+ALTER TABLE fields ADD COLUMN IF NOT EXISTS venue_id integer;
+ALTER TABLE fields ADD COLUMN IF NOT EXISTS sport_type varchar(30) NOT NULL DEFAULT '';
+ALTER TABLE fields ADD COLUMN IF NOT EXISTS surface varchar(30) NOT NULL DEFAULT '';
+ALTER TABLE fields ADD COLUMN IF NOT EXISTS is_indoor boolean NOT NULL DEFAULT false;
+ALTER TABLE fields ADD COLUMN IF NOT EXISTS capacity integer NOT NULL DEFAULT 0;
+ALTER TABLE fields ADD COLUMN IF NOT EXISTS amenities text[] NOT NULL DEFAULT '{}';
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'fk_venues_fields') THEN
+        ALTER TABLE fields ADD CONSTRAINT fk_venues_fields FOREIGN KEY (venue_id) REFERENCES venues (id)
+            ON UPDATE CASCADE ON DELETE SET NULL;
+    END IF;
+END $$;
+
+CREATE INDEX IF NOT EXISTS idx_fields_venue_id ON fields (venue_id);
+CREATE INDEX IF NOT EXISTS idx_fields_sport_type ON fields (sport_type);
+CREATE INDEX IF NOT EXISTS idx_fields_deleted_at ON fields (deleted_at);
+
+CREATE TABLE IF NOT EXISTS times (
+    id         bigserial PRIMARY KEY,
+    uuid       uuid NOT NULL,
+    field_id   integer,
+    start_time time without time zone NOT NULL,
+    end_time   time without time zone NOT NULL,
+    created_at timestamptz,
+    updated_at timestamptz,
+    CONSTRAINT fk_times_field FOREIGN KEY (field_id) REFERENCES fields (id)
+        ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+ALTER TABLE times ADD COLUMN IF NOT EXISTS field_id integer;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'fk_times_field') THEN
+        ALTER TABLE times ADD CONSTRAINT fk_times_field FOREIGN KEY (field_id) REFERENCES fields (id)
+            ON UPDATE CASCADE ON DELETE CASCADE;
+    END IF;
+END $$;
+
+CREATE INDEX IF NOT EXISTS idx_times_field_id ON times (field_id);
+
+CREATE TABLE IF NOT EXISTS field_schedules (
+    id              bigserial PRIMARY KEY,
+    uuid            uuid NOT NULL,
+    field_id        integer NOT NULL,
+    time_id         integer NOT NULL,
+    date            date NOT NULL,
+    status          integer NOT NULL,
+    held_by         uuid,
+    held_until      timestamptz,
+    closure_id      integer,
+    needs_follow_up boolean NOT NULL DEFAULT false,
+    price_per_hour  integer,
+    created_at      timestamptz,
+    updated_at      timestamptz,
+    deleted_at      timestamptz,
+    CONSTRAINT fk_field_schedules_field FOREIGN KEY (field_id) REFERENCES fields (id)
+        ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_field_schedules_time FOREIGN KEY (time_id) REFERENCES times (id)
+        ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+ALTER TABLE field_schedules ADD COLUMN IF NOT EXISTS held_by uuid;
+ALTER TABLE field_schedules ADD COLUMN IF NOT EXISTS held_until timestamptz;
+ALTER TABLE field_schedules ADD COLUMN IF NOT EXISTS closure_id integer;
+ALTER TABLE field_schedules ADD COLUMN IF NOT EXISTS needs_follow_up boolean NOT NULL DEFAULT false;
+ALTER TABLE field_schedules ADD COLUMN IF NOT EXISTS price_per_hour integer;
+
+-- Slot dobel (field, tanggal, time) yang masih aktif dibereskan dulu supaya unique index bisa dibuat.
+-- Satu slot dipertahankan (Booked didahulukan, lalu id terkecil), sisanya di-soft delete, bukan dihapus,
+-- supaya histori release tetap ada. Duplikat yang Booked ditandai needs_follow_up untuk ditindaklanjuti admin.
+WITH ranked AS (
+    SELECT id,
+           ROW_NUMBER() OVER (
+               PARTITION BY field_id, date, time_id
+               ORDER BY (status = 200) DESC, id ASC
+           ) AS duplicate_rank
+    FROM field_schedules
+    WHERE deleted_at IS NULL
+)
+UPDATE field_schedules
+SET deleted_at = now(),
+    needs_follow_up = (field_schedules.status = 200)
+FROM ranked
+WHERE ranked.id = field_schedules.id
+  AND ranked.duplicate_rank > 1;
+
+-- Unique index lama ikut menghitung slot yang sudah di-soft delete, diganti index parsial di bawah.
+DROP INDEX IF EXISTS idx_field_schedules_field_date_time;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_field_schedules_active_field_date_time
+    ON field_schedules (field_id, date, time_id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_field_schedules_created_at_id ON field_schedules (created_at, id);
+CREATE INDEX IF NOT EXISTS idx_field_schedules_held_until ON field_schedules (held_until);
+CREATE INDEX IF NOT EXISTS idx_field_schedules_closure_id ON field_schedules (closure_id);
+CREATE INDEX IF NOT EXISTS idx_field_schedules_deleted_at ON field_schedules (deleted_at);
+
+CREATE TABLE IF NOT EXISTS field_schedule_releases (
+    id                bigserial PRIMARY KEY,
+    uuid              uuid NOT NULL,
+    field_schedule_id integer NOT NULL,
+    previous_status   integer NOT NULL,
+    price_per_hour    integer,
+    reason            text NOT NULL,
+    released_by       varchar(100) NOT NULL,
+    created_at        timestamptz,
+    CONSTRAINT fk_field_schedule_releases_field_schedule FOREIGN KEY (field_schedule_id)
+        REFERENCES field_schedules (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_field_schedule_releases_field_schedule_id
+    ON field_schedule_releases (field_schedule_id);
+
+CREATE TABLE IF NOT EXISTS closures (
+    id         bigserial PRIMARY KEY,
+    uuid       uuid NOT NULL,
+    reason     varchar(255) NOT NULL,
+    start_date date NOT NULL,
+    end_date   date NOT NULL,
+    field_ids  text[] NOT NULL DEFAULT '{}',
+    created_at timestamptz,
+    updated_at timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS idx_closures_start_date ON closures (start_date);
+CREATE INDEX IF NOT EXISTS idx_closures_end_date ON closures (end_date);
+
+CREATE TABLE IF NOT EXISTS pricing_rules (
+    id              bigserial PRIMARY KEY,
+    uuid            uuid NOT NULL,
+    name            varchar(100) NOT NULL,
+    field_id        integer,
+    weekdays        integer[] NOT NULL DEFAULT '{}',
+    start_time      time without time zone,
+    end_time        time without time zone,
+    date            date,
+    adjustment_type varchar(20) NOT NULL,
+    value           integer NOT NULL,
+    priority        integer NOT NULL DEFAULT 0,
+    is_active       boolean NOT NULL DEFAULT true,
+    created_at      timestamptz,
+    updated_at      timestamptz,
+    CONSTRAINT fk_pricing_rules_field FOREIGN KEY (field_id) REFERENCES fields (id)
+        ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_pricing_rules_field_id ON pricing_rules (field_id);
+CREATE INDEX IF NOT EXISTS idx_pricing_rules_date ON pricing_rules (date);
+
+CREATE TABLE IF NOT EXISTS field_schedule_events (
+    id                  bigserial PRIMARY KEY,
+    uuid                uuid NOT NULL,
+    event_type          varchar(50) NOT NULL,
+    field_schedule_uuid uuid NOT NULL,
+    field_uuid          uuid NOT NULL,
+    new_field_uuid      uuid,
+    previous_date       date NOT NULL,
+    previous_time       varchar(30) NOT NULL,
+    new_date            date,
+    new_time            varchar(30),
+    price_per_hour      integer,
+    reason              text NOT NULL,
+    changed_by          varchar(100) NOT NULL,
+    created_at          timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS idx_field_schedule_events_field_schedule_uuid
+    ON field_schedule_events (field_schedule_uuid);
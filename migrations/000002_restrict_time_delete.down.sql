@@ -0,0 +1,3 @@
+ALTER TABLE field_schedules DROP CONSTRAINT IF EXISTS fk_field_schedules_time;
+ALTER TABLE field_schedules ADD CONSTRAINT fk_field_schedules_time FOREIGN KEY (time_id) REFERENCES times (id)
+    ON UPDATE CASCADE ON DELETE CASCADE;
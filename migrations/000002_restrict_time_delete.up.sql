@@ -0,0 +1,5 @@
+-- Jadwal (termasuk histori booking) tidak boleh ikut terhapus saat time slot dihapus.
+-- Sebelumnya time_id ON DELETE CASCADE, yang juga menghapus catatan release lewat cascade berikutnya.
+ALTER TABLE field_schedules DROP CONSTRAINT IF EXISTS fk_field_schedules_time;
+ALTER TABLE field_schedules ADD CONSTRAINT fk_field_schedules_time FOREIGN KEY (time_id) REFERENCES times (id)
+    ON UPDATE CASCADE ON DELETE RESTRICT;
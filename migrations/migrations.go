@@ -0,0 +1,289 @@
+package migrations
+
+import (
+	"context"
+	"embed"
+	"field-service/constants"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// files berisi semua file migration yang ikut ter-embed di binary.
+// Nama file: <versi>_<nama>.up.sql dan <versi>_<nama>.down.sql, versi diurutkan sebagai angka.
+//
+//go:embed *.sql
+var files embed.FS
+
+const tableName = "schema_migrations"
+
+var filePattern = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status adalah status satu migration; AppliedAt nil artinya belum dijalankan.
+type Status struct {
+	Version   int64
+	Name      string
+	AppliedAt *time.Time
+}
+
+type appliedMigration struct {
+	Version   int64
+	Name      string
+	AppliedAt time.Time
+}
+
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+// NewMigrator membaca migration yang ter-embed dan memastikan setiap versi punya file up.
+func NewMigrator(db *gorm.DB) (*Migrator, error) {
+	migrations, err := load()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+func load() ([]Migration, error) {
+	entries, err := fs.ReadDir(files, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		matches := filePattern.FindStringSubmatch(entry.Name())
+		if matches == nil {
+			return nil, fmt.Errorf("nama file migration tidak valid: %s", entry.Name())
+		}
+
+		version, err := strconv.ParseInt(matches[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("versi migration tidak valid: %s", entry.Name())
+		}
+
+		content, err := files.ReadFile(entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: matches[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != matches[2] {
+			return nil, fmt.Errorf("versi migration %d dipakai dua nama: %s dan %s", version, migration.Name, matches[2])
+		}
+
+		if matches[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" {
+			return nil, fmt.Errorf("migration %d_%s tidak punya file up", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// Up menjalankan semua migration yang belum dijalankan, berurutan dari versi terkecil.
+// Setiap migration berjalan di transaksinya sendiri bersama pencatatan di schema_migrations.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var executed []Migration
+	err := m.withLock(ctx, func(conn *gorm.DB) error {
+		applied, err := m.applied(conn)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range m.migrations {
+			if _, ok := applied[migration.Version]; ok {
+				continue
+			}
+
+			fmt.Printf("⬆️ [INFO-MIGRATION] Menjalankan migration %d_%s\n", migration.Version, migration.Name)
+			err = conn.Transaction(func(tx *gorm.DB) error {
+				err := tx.Exec(migration.Up).Error
+				if err != nil {
+					return err
+				}
+
+				return tx.Exec(
+					"INSERT INTO "+tableName+" (version, name, applied_at) VALUES (?, ?, ?)",
+					migration.Version,
+					migration.Name,
+					time.Now(),
+				).Error
+			})
+			if err != nil {
+				return fmt.Errorf("migration %d_%s gagal: %w", migration.Version, migration.Name, err)
+			}
+
+			executed = append(executed, migration)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return executed, err
+	}
+
+	return executed, nil
+}
+
+// Down membatalkan steps migration terakhir yang sudah dijalankan, dari versi terbesar.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	var reverted []Migration
+	err := m.withLock(ctx, func(conn *gorm.DB) error {
+		applied, err := m.applied(conn)
+		if err != nil {
+			return err
+		}
+
+		for i := len(m.migrations) - 1; i >= 0 && len(reverted) < steps; i-- {
+			migration := m.migrations[i]
+			if _, ok := applied[migration.Version]; !ok {
+				continue
+			}
+
+			if migration.Down == "" {
+				return fmt.Errorf("migration %d_%s tidak punya file down", migration.Version, migration.Name)
+			}
+
+			fmt.Printf("⬇️ [INFO-MIGRATION] Membatalkan migration %d_%s\n", migration.Version, migration.Name)
+			err = conn.Transaction(func(tx *gorm.DB) error {
+				err := tx.Exec(migration.Down).Error
+				if err != nil {
+					return err
+				}
+
+				return tx.Exec("DELETE FROM "+tableName+" WHERE version = ?", migration.Version).Error
+			})
+			if err != nil {
+				return fmt.Errorf("rollback migration %d_%s gagal: %w", migration.Version, migration.Name, err)
+			}
+
+			reverted = append(reverted, migration)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return reverted, err
+	}
+
+	return reverted, nil
+}
+
+// Status mengembalikan semua migration yang ter-embed beserta waktu dijalankannya.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	applied, err := m.applied(m.db.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := Status{Version: migration.Version, Name: migration.Name}
+		if item, ok := applied[migration.Version]; ok {
+			status.AppliedAt = &item.AppliedAt
+		}
+		results = append(results, status)
+	}
+
+	return results, nil
+}
+
+// Pending mengembalikan migration yang belum dijalankan. Dipakai serve untuk menolak start
+// kalau schema database tertinggal dari binary.
+func (m *Migrator) Pending(ctx context.Context) ([]Migration, error) {
+	applied, err := m.applied(m.db.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for _, migration := range m.migrations {
+		if _, ok := applied[migration.Version]; !ok {
+			pending = append(pending, migration)
+		}
+	}
+
+	return pending, nil
+}
+
+// withLock menjalankan fn di satu koneksi yang memegang pg_advisory_lock, jadi kalau beberapa replica
+// menjalankan migrate bersamaan, yang lain menunggu lalu melihat migration sudah dijalankan.
+func (m *Migrator) withLock(ctx context.Context, fn func(*gorm.DB) error) error {
+	return m.db.WithContext(ctx).Connection(func(conn *gorm.DB) error {
+		err := conn.Exec("SELECT pg_advisory_lock(?)", constants.MigrationLockKey).Error
+		if err != nil {
+			return fmt.Errorf("gagal mengambil lock migration: %w", err)
+		}
+		fmt.Println("🔒 [INFO-MIGRATION] Lock migration didapat")
+
+		defer func() {
+			err := conn.Exec("SELECT pg_advisory_unlock(?)", constants.MigrationLockKey).Error
+			if err != nil {
+				fmt.Println("❌ [ERROR-MIGRATION] Gagal melepas lock migration:", err)
+			}
+		}()
+
+		err = conn.Exec(
+			"CREATE TABLE IF NOT EXISTS " + tableName + " (" +
+				"version bigint PRIMARY KEY, " +
+				"name varchar(255) NOT NULL, " +
+				"applied_at timestamptz NOT NULL)",
+		).Error
+		if err != nil {
+			return fmt.Errorf("gagal membuat tabel %s: %w", tableName, err)
+		}
+
+		return fn(conn)
+	})
+}
+
+// applied membaca versi yang sudah dijalankan. Tabel yang belum ada artinya belum ada migration sama sekali.
+func (m *Migrator) applied(db *gorm.DB) (map[int64]appliedMigration, error) {
+	results := make(map[int64]appliedMigration)
+	if !db.Migrator().HasTable(tableName) {
+		return results, nil
+	}
+
+	var rows []appliedMigration
+	err := db.Table(tableName).Order("version asc").Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("gagal membaca tabel %s: %w", tableName, err)
+	}
+
+	for _, row := range rows {
+		results[row.Version] = row
+	}
+	return results, nil
+}
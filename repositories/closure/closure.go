@@ -0,0 +1,106 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	errWrap "field-service/common/error"
+	errConstant "field-service/constants/error"
+	errClosure "field-service/constants/error/closure"
+	"field-service/domain/models"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ClosureRepository struct {
+	db *gorm.DB
+}
+
+type IClosureRepository interface {
+	FindAll(context.Context) ([]models.Closure, error)
+	FindByUUID(context.Context, string) (*models.Closure, error)
+	FindOverlappingByFieldID(context.Context, string, string, string) ([]models.Closure, error)
+	Create(context.Context, *models.Closure) (*models.Closure, error)
+	Delete(context.Context, string) error
+}
+
+func NewClosureRepository(db *gorm.DB) IClosureRepository {
+	return &ClosureRepository{db: db}
+}
+
+func (c *ClosureRepository) FindAll(ctx context.Context) ([]models.Closure, error) {
+	fmt.Println("🔍 [DEBUG-REPOSITORIES] Mengambil semua data closure")
+	var closures []models.Closure
+	err := c.db.WithContext(ctx).Order("start_date desc").Find(&closures).Error
+	if err != nil {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal mengambil data closure:", err)
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	fmt.Println("✅ [INFO-REPOSITORIES] Berhasil mengambil data closure:", len(closures))
+	return closures, nil
+}
+
+func (c *ClosureRepository) FindByUUID(ctx context.Context, uuid string) (*models.Closure, error) {
+	var closure models.Closure
+	err := c.db.WithContext(ctx).Where("uuid = ?", uuid).First(&closure).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			fmt.Println("❌ [ERROR-REPOSITORIES] Data closure tidak ditemukan")
+			return nil, errWrap.WrapError(errClosure.ErrClosureNotFound)
+		}
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal mengambil data closure:", err)
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	fmt.Println("✅ [INFO-REPOSITORIES] Berhasil mengambil data closure:", closure.UUID)
+	return &closure, nil
+}
+
+// FindOverlappingByFieldID mengambil closure yang beririsan dengan rentang startDate - endDate
+// dan berlaku untuk fieldID (UUID) atau untuk semua field.
+func (c *ClosureRepository) FindOverlappingByFieldID(
+	ctx context.Context,
+	fieldID string,
+	startDate string,
+	endDate string,
+) ([]models.Closure, error) {
+	var closures []models.Closure
+	err := c.db.
+		WithContext(ctx).
+		Where("start_date <= ?", endDate).
+		Where("end_date >= ?", startDate).
+		Where("cardinality(field_ids) = 0 OR ? = ANY(field_ids)", fieldID).
+		Find(&closures).
+		Error
+	if err != nil {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal mengambil data closure:", err)
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return closures, nil
+}
+
+func (c *ClosureRepository) Create(ctx context.Context, closure *models.Closure) (*models.Closure, error) {
+	closure.UUID = uuid.New()
+	err := c.db.WithContext(ctx).Create(closure).Error
+	if err != nil {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal membuat data closure:", err)
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	fmt.Println("✅ [INFO-REPOSITORIES] Berhasil membuat data closure:", closure.UUID)
+	return closure, nil
+}
+
+func (c *ClosureRepository) Delete(ctx context.Context, uuid string) error {
+	err := c.db.WithContext(ctx).Where("uuid = ?", uuid).Delete(&models.Closure{}).Error
+	if err != nil {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal menghapus data closure:", err)
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	fmt.Println("✅ [INFO-REPOSITORIES] Berhasil menghapus data closure:", uuid)
+	return nil
+}
@@ -4,12 +4,17 @@ import (
 	"context"
 	"errors"
 	errWrap "field-service/common/error"
+	"field-service/common/util"
 	errConstant "field-service/constants/error"
+	errField "field-service/constants/error/field"
+	errFieldSchedule "field-service/constants/error/fieldschedule"
 	"field-service/domain/dto"
 	"field-service/domain/models"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"gorm.io/gorm"
 )
 
@@ -19,13 +24,33 @@ type FieldRepository struct {
 
 type IFieldRepository interface {
 	FindAllWithPagination(context.Context, *dto.FieldRequestParam) ([]models.Field, int64, error)
-	FindAllWithoutPagination(context.Context) ([]models.Field, error)
+	FindAllWithoutPagination(context.Context, *dto.FieldFilterParam) ([]models.Field, error)
 	FindByUUID(context.Context, string) (*models.Field, error)
 	Create(context.Context, *models.Field) (*models.Field, error)
 	Update(context.Context, string, *models.Field) (*models.Field, error)
-	Delete(context.Context, string) error
+	Delete(context.Context, string, string) error
+	FindAllDeleted(context.Context) ([]models.Field, error)
+	Restore(context.Context, string) (*models.Field, error)
 }
 
+// fieldQuery berisi key sort publik untuk list field. Key snake_case lama tetap diterima
+// supaya client yang sudah ada tidak rusak.
+var fieldQuery = util.NewQueryBuilder(
+	map[string]string{
+		"name":           "name",
+		"code":           "code",
+		"pricePerHour":   "price_per_hour",
+		"price_per_hour": "price_per_hour",
+		"capacity":       "capacity",
+		"createdAt":      "created_at",
+		"created_at":     "created_at",
+		"updatedAt":      "updated_at",
+		"updated_at":     "updated_at",
+	},
+	nil,
+	"created_at desc",
+)
+
 func NewFieldRepository(db *gorm.DB) IFieldRepository {
 	return &FieldRepository{db: db}
 }
@@ -34,48 +59,29 @@ func (f *FieldRepository) FindAllWithPagination(
 	ctx context.Context,
 	param *dto.FieldRequestParam,
 ) ([]models.Field, int64, error) {
-	var (
-		fields []models.Field
-		sort   string
-		total  int64
-	)
+	var fields []models.Field
 
+	// 📝 Catatan:
+	// Kolom sort diambil dari whitelist, bukan langsung dari query param (cegah SQL injection).
 	fmt.Println("🔍 [DEBUG-REPOSITORIES] Sort Column:", param.SortColumn)
 	fmt.Println("🔍 [DEBUG-REPOSITORIES] Sort Order:", param.SortOrder)
-	if param.SortColumn != nil {
-		fmt.Println("🔍 [DEBUG-REPOSITORIES] Sort Column ada, menggunakan:", *param.SortColumn, *param.SortOrder)
-		sort = fmt.Sprintf("%s %s", *param.SortColumn, *param.SortOrder)
-	} else {
-		fmt.Println("🔍 [DEBUG-REPOSITORIES] Sort Column tidak ada, menggunakan default: created_at desc")
-		sort = "created_at desc"
-	}
-
-	limit := param.Limit
-	offset := (param.Page - 1) * limit
-	err := f.db.
-		WithContext(ctx).
-		Limit(limit).
-		Offset(offset).
-		Order(sort).
-		Find(&fields).Error
-
-	fmt.Println("🔍 [DEBUG-REPOSITORIES] Data field:", fields)
-
+	sort, err := fieldQuery.OrderBy(param.SortColumn, param.SortOrder)
 	if err != nil {
-		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal mengambil data field:", err)
-		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+		return nil, 0, errWrap.WrapError(err)
 	}
 
-	err = f.db.
+	// 📝 Catatan:
+	// Filter dipasang sekali di query dasar, util.Paginate memakainya untuk data dan count.
+	query := f.db.
 		WithContext(ctx).
-		Model(&fields).
-		Count(&total).
-		Error
+		Model(&models.Field{}).
+		Preload("Venue").
+		Scopes(f.filterScope(&param.FieldFilterParam))
 
+	total, err := util.Paginate(query, param.Page, param.Limit, sort, &fields)
 	fmt.Println("🔍 [DEBUG-REPOSITORIES] Total data field:", total)
-
 	if err != nil {
-		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal menghitung total data field:", err)
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal mengambil data field:", err)
 		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
 	}
 
@@ -83,11 +89,16 @@ func (f *FieldRepository) FindAllWithPagination(
 	return fields, total, nil
 }
 
-func (f *FieldRepository) FindAllWithoutPagination(ctx context.Context) ([]models.Field, error) {
+func (f *FieldRepository) FindAllWithoutPagination(
+	ctx context.Context,
+	filter *dto.FieldFilterParam,
+) ([]models.Field, error) {
 	var fields []models.Field
 	fmt.Println("🔍 [DEBUG-REPOSITORIES] Mengambil semua data field")
 	err := f.db.
 		WithContext(ctx).
+		Preload("Venue").
+		Scopes(f.filterScope(filter)).
 		Find(&fields).
 		Error
 
@@ -107,6 +118,7 @@ func (f *FieldRepository) FindByUUID(ctx context.Context, uuid string) (*models.
 
 	err := f.db.
 		WithContext(ctx).
+		Preload("Venue").
 		Where("uuid = ?", uuid).
 		First(&fields).
 		Error
@@ -127,10 +139,16 @@ func (f *FieldRepository) Create(ctx context.Context, req *models.Field) (*model
 	fmt.Println("🔍 [DEBUG-REPOSITORIES] Membuat data field baru")
 	field := models.Field{
 		UUID:         uuid.New(),
+		VenueID:      req.VenueID,
 		Code:         req.Code,
 		Name:         req.Name,
 		Images:       req.Images,
 		PricePerHour: req.PricePerHour,
+		SportType:    req.SportType,
+		Surface:      req.Surface,
+		IsIndoor:     req.IsIndoor,
+		Capacity:     req.Capacity,
+		Amenities:    req.Amenities,
 	}
 
 	err := f.db.WithContext(ctx).Create(&field).Error
@@ -146,13 +164,28 @@ func (f *FieldRepository) Create(ctx context.Context, req *models.Field) (*model
 func (f *FieldRepository) Update(ctx context.Context, uuid string, req *models.Field) (*models.Field, error) {
 	fmt.Println("🔍 [DEBUG-REPOSITORIES] Memperbarui data field dengan UUID:", uuid)
 	field := models.Field{
+		VenueID:      req.VenueID,
 		Code:         req.Code,
 		Name:         req.Name,
 		Images:       req.Images,
 		PricePerHour: req.PricePerHour,
+		SportType:    req.SportType,
+		Surface:      req.Surface,
+		IsIndoor:     req.IsIndoor,
+		Capacity:     req.Capacity,
+		Amenities:    req.Amenities,
 	}
 
-	err := f.db.WithContext(ctx).Where("uuid = ?", uuid).Updates(&field).Error
+	// 📝 Catatan:
+	// Kolom di-Select eksplisit supaya nilai kosong (isIndoor=false, amenities kosong) ikut tersimpan.
+	err := f.db.
+		WithContext(ctx).
+		Model(&models.Field{}).
+		Where("uuid = ?", uuid).
+		Select("venue_id", "code", "name", "images", "price_per_hour",
+			"sport_type", "surface", "is_indoor", "capacity", "amenities").
+		Updates(&field).
+		Error
 	if err != nil {
 		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal memperbarui data field:", err)
 		return nil, errWrap.WrapError(errConstant.ErrSQLError)
@@ -162,10 +195,38 @@ func (f *FieldRepository) Update(ctx context.Context, uuid string, req *models.F
 	return &field, nil
 }
 
-func (f *FieldRepository) Delete(ctx context.Context, uuid string) error {
-	fmt.Println("🔍 [DEBUG-REPOSITORIES] Menghapus data field dengan UUID:", uuid)
-	err := f.db.WithContext(ctx).Where("uuid = ?", uuid).Delete(&models.Field{}).Error
+// Delete melakukan soft delete field beserta jadwalnya mulai scheduleFromDate (YYYY-MM-DD).
+// Jadwal sebelum tanggal itu tetap ada sebagai riwayat. Field dan jadwal diberi deleted_at yang
+// sama supaya Restore bisa mengembalikan jadwal yang ikut terhapus saja.
+func (f *FieldRepository) Delete(ctx context.Context, uuid string, scheduleFromDate string) error {
+	fmt.Println("🔍 [DEBUG-REPOSITORIES] Menghapus (soft delete) data field dengan UUID:", uuid)
+	err := f.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var field models.Field
+		err := tx.Where("uuid = ?", uuid).First(&field).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errField.ErrFieldNotFound
+			}
+			return err
+		}
+
+		deletedAt := time.Now()
+		err = tx.Model(&field).Update("deleted_at", deletedAt).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.
+			Model(&models.FieldSchedule{}).
+			Where("field_id = ?", field.ID).
+			Where("date >= ?", scheduleFromDate).
+			Update("deleted_at", deletedAt).
+			Error
+	})
 	if err != nil {
+		if errors.Is(err, errField.ErrFieldNotFound) {
+			return errWrap.WrapError(err)
+		}
 		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal menghapus data field:", err)
 		return errWrap.WrapError(errConstant.ErrSQLError)
 	}
@@ -173,3 +234,127 @@ func (f *FieldRepository) Delete(ctx context.Context, uuid string) error {
 	fmt.Println("✅ [INFO-REPOSITORIES] Berhasil menghapus data field dengan UUID:", uuid)
 	return nil
 }
+
+// FindAllDeleted mengambil field yang sudah di-soft delete (trash), terbaru dulu.
+func (f *FieldRepository) FindAllDeleted(ctx context.Context) ([]models.Field, error) {
+	var fields []models.Field
+	err := f.db.
+		WithContext(ctx).
+		Unscoped().
+		Preload("Venue").
+		Where("deleted_at IS NOT NULL").
+		Order("deleted_at desc").
+		Find(&fields).
+		Error
+	if err != nil {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal mengambil data field terhapus:", err)
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	fmt.Println("✅ [INFO-REPOSITORIES] Berhasil mengambil data field terhapus:", len(fields))
+	return fields, nil
+}
+
+// Restore mengembalikan field yang sudah di-soft delete beserta jadwal yang ikut terhapus bersamanya.
+// Jadwal yang slotnya sudah dibuat ulang selama field terhapus tidak ikut dikembalikan.
+func (f *FieldRepository) Restore(ctx context.Context, uuid string) (*models.Field, error) {
+	fmt.Println("🔍 [DEBUG-REPOSITORIES] Restore data field dengan UUID:", uuid)
+	var field models.Field
+	err := f.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Unscoped().
+			Where("uuid = ?", uuid).
+			Where("deleted_at IS NOT NULL").
+			First(&field).
+			Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errField.ErrFieldNotFound
+			}
+			return err
+		}
+
+		// 📝 Catatan:
+		// Selama field terhapus, slot yang sama (field, tanggal, time) bisa sudah dibuat ulang.
+		// Jadwal seperti itu dilewati (tetap terhapus) supaya tidak bentrok dengan unique index slot aktif.
+		result := tx.
+			Model(&models.FieldSchedule{}).
+			Unscoped().
+			Where("field_id = ?", field.ID).
+			Where("deleted_at = ?", field.DeletedAt.Time).
+			Where("NOT EXISTS (SELECT 1 FROM field_schedules AS active "+
+				"WHERE active.field_id = field_schedules.field_id "+
+				"AND active.date = field_schedules.date "+
+				"AND active.time_id = field_schedules.time_id "+
+				"AND active.deleted_at IS NULL)").
+			Update("deleted_at", nil)
+		if result.Error != nil {
+			return result.Error
+		}
+		fmt.Println("✅ [INFO-REPOSITORIES] Jadwal field dikembalikan:", result.RowsAffected)
+
+		var skipped int64
+		err = tx.
+			Model(&models.FieldSchedule{}).
+			Unscoped().
+			Where("field_id = ?", field.ID).
+			Where("deleted_at = ?", field.DeletedAt.Time).
+			Count(&skipped).
+			Error
+		if err != nil {
+			return err
+		}
+		if skipped > 0 {
+			fmt.Println("⚠️ [WARN-REPOSITORIES] Jadwal field dilewati karena slotnya sudah dibuat ulang:", skipped)
+		}
+
+		err = tx.Unscoped().Model(&field).Update("deleted_at", nil).Error
+		if err != nil {
+			return err
+		}
+		return tx.Preload("Venue").First(&field, field.ID).Error
+	})
+	if err != nil {
+		if errors.Is(err, errField.ErrFieldNotFound) {
+			return nil, errWrap.WrapError(err)
+		}
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			fmt.Println("⚠️ [WARN-REPOSITORIES] Jadwal field bentrok dengan slot aktif:", err)
+			return nil, errWrap.WrapError(errFieldSchedule.ErrFieldScheduleIsExist)
+		}
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal restore data field:", err)
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	fmt.Println("✅ [INFO-REPOSITORIES] Berhasil restore data field dengan UUID:", uuid)
+	return &field, nil
+}
+
+// filterScope menerapkan filter listing field. Dipakai untuk query data dan count supaya totalnya sama.
+func (f *FieldRepository) filterScope(filter *dto.FieldFilterParam) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if filter == nil {
+			return db
+		}
+
+		if filter.VenueID != nil && *filter.VenueID != "" {
+			db = db.Where("venue_id = (SELECT id FROM venues WHERE uuid = ?)", *filter.VenueID)
+		}
+		if filter.SportType != nil && *filter.SportType != "" {
+			db = db.Where("sport_type = ?", *filter.SportType)
+		}
+		if filter.Surface != nil && *filter.Surface != "" {
+			db = db.Where("surface = ?", *filter.Surface)
+		}
+		if filter.IsIndoor != nil {
+			db = db.Where("is_indoor = ?", *filter.IsIndoor)
+		}
+		if filter.MinCapacity != nil {
+			db = db.Where("capacity >= ?", *filter.MinCapacity)
+		}
+		if len(filter.Amenities) > 0 {
+			db = db.Where("amenities @> ?", pq.StringArray(filter.Amenities))
+		}
+		return db
+	}
+}
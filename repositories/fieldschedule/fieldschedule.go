@@ -4,14 +4,19 @@ import (
 	"context"
 	"errors"
 	errWrap "field-service/common/error"
+	"field-service/common/util"
 	"field-service/constants"
 	errConstant "field-service/constants/error"
+	errField "field-service/constants/error/field"
 	errFieldSchedule "field-service/constants/error/fieldschedule"
 	"field-service/domain/dto"
 	"field-service/domain/models"
 	"fmt"
+	"time"
 
+	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type FieldScheduleRepository struct {
@@ -20,15 +25,60 @@ type FieldScheduleRepository struct {
 
 type IFieldScheduleRepository interface {
 	FindAllWithPagination(context.Context, *dto.FieldScheduleRequestParam) ([]models.FieldSchedule, int64, error)
+	FindAllWithCursor(context.Context, *dto.FieldScheduleCursorRequestParam) ([]models.FieldSchedule, *int64, error)
 	FindAllByFieldIDAndDate(context.Context, int, string) ([]models.FieldSchedule, error)
+	FindAvailable(context.Context, *dto.FieldAvailabilityRequestParam) ([]models.FieldSchedule, error)
 	FindByUUID(context.Context, string) (*models.FieldSchedule, error)
 	FindByDateAndTimeID(context.Context, string, int, int) (*models.FieldSchedule, error)
+	CountByTimeID(context.Context, uint) (int64, error)
+	CountBookedFromDateByFieldID(context.Context, uint, string) (int64, error)
+	LockFromDateByFieldID(context.Context, uint, string) error
+	FindAllDeletedWithPagination(context.Context, *dto.FieldScheduleRequestParam) ([]models.FieldSchedule, int64, error)
+	Restore(context.Context, string) (*models.FieldSchedule, error)
 	Create(context.Context, []models.FieldSchedule) error
-	Update(context.Context, string, *models.FieldSchedule) (*models.FieldSchedule, error)
+	CreateSkipExisting(context.Context, []models.FieldSchedule) (int64, error)
+	BlockByClosure(context.Context, *models.Closure, []constants.FieldScheduleStatus) (int64, int64, error)
+	UnblockByClosure(context.Context, uint) (int64, error)
+	Update(
+		context.Context,
+		string,
+		*models.FieldSchedule,
+		FieldScheduleValidator,
+		FieldScheduleEventBuilder,
+		FieldSchedulePricer,
+	) (*models.FieldSchedule, error)
 	UpdateStatus(context.Context, constants.FieldScheduleStatus, string) error
-	Delete(context.Context, string) error
+	UpdateStatusInBatch(context.Context, constants.FieldScheduleStatus, []string, FieldScheduleValidator) error
+	BookInBatch(context.Context, []string, FieldScheduleValidator, FieldSchedulePricer) error
+	HoldInBatch(context.Context, []string, uuid.UUID, time.Time, FieldScheduleValidator) error
+	ReleaseExpiredHolds(context.Context, time.Time) (int64, error)
+	ReleaseInBatch(context.Context, constants.FieldScheduleStatus, []string, string, string, FieldScheduleValidator) error
+	Delete(context.Context, string, FieldScheduleValidator, FieldScheduleEventBuilder) error
 }
 
+// createBatchSize menjaga jumlah parameter satu INSERT di bawah batas Postgres (65535).
+// Generate satu bulan normalnya tetap masuk dalam satu statement.
+const createBatchSize = 1000
+
+// fieldScheduleQuery berisi key sort & filter publik untuk list jadwal. Key snake_case lama
+// tetap diterima supaya client yang sudah ada tidak rusak.
+var fieldScheduleQuery = util.NewQueryBuilder(
+	map[string]string{
+		"date":       "date",
+		"status":     "status",
+		"createdAt":  "created_at",
+		"created_at": "created_at",
+		"updatedAt":  "updated_at",
+		"updated_at": "updated_at",
+	},
+	map[string]string{
+		"status":  "status",
+		"fieldID": "field_id",
+		"date":    "date",
+	},
+	"created_at desc",
+)
+
 func NewFieldScheduleRepository(db *gorm.DB) IFieldScheduleRepository {
 	return &FieldScheduleRepository{db: db}
 }
@@ -37,57 +87,216 @@ func (f *FieldScheduleRepository) FindAllWithPagination(
 	ctx context.Context,
 	param *dto.FieldScheduleRequestParam,
 ) ([]models.FieldSchedule, int64, error) {
-	var (
-		fieldSchedules []models.FieldSchedule
-		sort           string
-		total          int64
-	)
+	var fieldSchedules []models.FieldSchedule
 
+	// 📝 Catatan:
+	// Kolom sort & filter diambil dari whitelist, bukan langsung dari query param (cegah SQL injection).
 	fmt.Println("🔍 [DEBUG-REPOSITORIES] Sort Column:", param.SortColumn)
 	fmt.Println("🔍 [DEBUG-REPOSITORIES] Sort Order:", param.SortOrder)
-	if param.SortColumn != nil {
-		fmt.Println("🔍 [DEBUG-REPOSITORIES] Sort Column ada, menggunakan:", *param.SortColumn, *param.SortOrder)
-		sort = fmt.Sprintf("%s %s", *param.SortColumn, *param.SortOrder)
-	} else {
-		fmt.Println("🔍 [DEBUG-REPOSITORIES] Sort Column tidak ada, menggunakan default: created_at desc")
-		sort = "created_at desc"
+	sort, err := fieldScheduleQuery.OrderBy(param.SortColumn, param.SortOrder)
+	if err != nil {
+		return nil, 0, errWrap.WrapError(err)
 	}
 
-	limit := param.Limit
-	offset := (param.Page - 1) * limit
-	err := f.db.
+	filterScope := fieldScheduleQuery.Scope(f.listFilters(&param.FieldScheduleFilterParam))
+
+	query := f.db.
 		WithContext(ctx).
-		Preload("Field").
+		Model(&models.FieldSchedule{}).
+		Preload("Field.Venue").
 		Preload("Time").
-		Limit(limit).
-		Offset(offset).
-		Order(sort).
-		Find(&fieldSchedules).Error
-
-	fmt.Println("🔍 [DEBUG-REPOSITORIES] Data field:", fieldSchedules)
+		Scopes(filterScope)
 
+	total, err := util.Paginate(query, param.Page, param.Limit, sort, &fieldSchedules)
+	fmt.Println("🔍 [DEBUG-REPOSITORIES] Total data field:", total)
 	if err != nil {
 		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal mengambil data field:", err)
-		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+		return nil, 0, errWrap.WrapError(util.QueryError(err))
 	}
 
+	fmt.Println("✅ [INFO-REPOSITORIES] Berhasil mengambil data field dengan total:", total)
+	return fieldSchedules, total, nil
+}
+
+// FindAllWithCursor mengambil jadwal dengan keyset pagination (created_at desc nulls first, id desc) tanpa OFFSET.
+// Mengambil param.Limit+1 baris supaya service tahu masih ada halaman berikutnya.
+// Total hanya dihitung kalau param.WithTotal, dengan filter yang sama seperti query datanya.
+func (f *FieldScheduleRepository) FindAllWithCursor(
+	ctx context.Context,
+	param *dto.FieldScheduleCursorRequestParam,
+) ([]models.FieldSchedule, *int64, error) {
+	var fieldSchedules []models.FieldSchedule
+
+	filterScope := fieldScheduleQuery.Scope(f.listFilters(&param.FieldScheduleFilterParam))
+	query := f.db.
+		WithContext(ctx).
+		Preload("Field.Venue").
+		Preload("Time").
+		Scopes(filterScope)
+
+	if param.Cursor != nil && *param.Cursor != "" {
+		cursor, err := util.DecodeCursor(*param.Cursor)
+		if err != nil {
+			fmt.Println("❌ [ERROR-REPOSITORIES] Cursor tidak valid:", *param.Cursor)
+			return nil, nil, errWrap.WrapError(err)
+		}
+		// 📝 Catatan:
+		// Baris tanpa created_at ada di awal urutan (desc nulls first) dan diurutkan dengan id saja,
+		// jadi cursor dari baris NULL melanjutkan sisa baris NULL lalu semua baris yang punya created_at.
+		if cursor.CreatedAt == nil {
+			query = query.Where("((created_at IS NULL AND id < ?) OR created_at IS NOT NULL)", cursor.ID)
+		} else {
+			query = query.Where("(created_at, id) < (?, ?)", *cursor.CreatedAt, cursor.ID)
+		}
+	}
+
+	err := query.
+		Order("created_at desc nulls first").
+		Order("id desc").
+		Limit(param.Limit + 1).
+		Find(&fieldSchedules).
+		Error
+	if err != nil {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal mengambil data field schedule:", err)
+		return nil, nil, errWrap.WrapError(util.QueryError(err))
+	}
+
+	if !param.WithTotal {
+		return fieldSchedules, nil, nil
+	}
+
+	var total int64
 	err = f.db.
 		WithContext(ctx).
-		Model(&fieldSchedules).
+		Model(&models.FieldSchedule{}).
+		Scopes(filterScope).
 		Count(&total).
 		Error
+	if err != nil {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal menghitung total data field schedule:", err)
+		return nil, nil, errWrap.WrapError(util.QueryError(err))
+	}
 
-	fmt.Println("🔍 [DEBUG-REPOSITORIES] Total data field:", total)
+	fmt.Println("✅ [INFO-REPOSITORIES] Berhasil mengambil data field schedule dengan cursor, total:", total)
+	return fieldSchedules, &total, nil
+}
 
+// FindAllDeletedWithPagination mengambil jadwal yang sudah di-soft delete (trash) dengan filter yang sama
+// seperti list biasa. Field di-preload tanpa scope soft delete supaya jadwal dari field terhapus tetap punya nama.
+func (f *FieldScheduleRepository) FindAllDeletedWithPagination(
+	ctx context.Context,
+	param *dto.FieldScheduleRequestParam,
+) ([]models.FieldSchedule, int64, error) {
+	var fieldSchedules []models.FieldSchedule
+
+	sort, err := fieldScheduleQuery.OrderBy(param.SortColumn, param.SortOrder)
 	if err != nil {
-		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal menghitung total data field:", err)
-		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+		return nil, 0, errWrap.WrapError(err)
 	}
 
-	fmt.Println("✅ [INFO-REPOSITORIES] Berhasil mengambil data field dengan total:", total)
+	query := f.db.
+		WithContext(ctx).
+		Unscoped().
+		Model(&models.FieldSchedule{}).
+		Preload("Field", func(db *gorm.DB) *gorm.DB { return db.Unscoped() }).
+		Preload("Field.Venue").
+		Preload("Time").
+		Where("deleted_at IS NOT NULL").
+		Scopes(fieldScheduleQuery.Scope(f.listFilters(&param.FieldScheduleFilterParam)))
+
+	total, err := util.Paginate(query, param.Page, param.Limit, sort, &fieldSchedules)
+	if err != nil {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal mengambil data field schedule terhapus:", err)
+		return nil, 0, errWrap.WrapError(util.QueryError(err))
+	}
+
+	fmt.Println("✅ [INFO-REPOSITORIES] Berhasil mengambil data field schedule terhapus, total:", total)
 	return fieldSchedules, total, nil
 }
 
+// Restore mengembalikan jadwal yang sudah di-soft delete. Gagal kalau fieldnya masih terhapus
+// atau slot yang sama (field, tanggal, time) sudah dibuat ulang.
+func (f *FieldScheduleRepository) Restore(ctx context.Context, uuid string) (*models.FieldSchedule, error) {
+	fmt.Println("🔍 [DEBUG-REPOSITORIES] Restore data field schedule dengan UUID:", uuid)
+	var fieldSchedule models.FieldSchedule
+	err := f.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Unscoped().
+			Where("uuid = ?", uuid).
+			Where("deleted_at IS NOT NULL").
+			First(&fieldSchedule).
+			Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errFieldSchedule.ErrFieldScheduleNotFound
+			}
+			return err
+		}
+
+		var field models.Field
+		err = tx.First(&field, fieldSchedule.FieldID).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errField.ErrFieldNotFound
+			}
+			return err
+		}
+
+		var existing int64
+		err = tx.
+			Model(&models.FieldSchedule{}).
+			Where("field_id = ?", fieldSchedule.FieldID).
+			Where("date = ?", fieldSchedule.Date.Format(time.DateOnly)).
+			Where("time_id = ?", fieldSchedule.TimeID).
+			Count(&existing).
+			Error
+		if err != nil {
+			return err
+		}
+		if existing > 0 {
+			return errFieldSchedule.ErrFieldScheduleIsExist
+		}
+
+		err = tx.Unscoped().Model(&fieldSchedule).Update("deleted_at", nil).Error
+		if err != nil {
+			return err
+		}
+		return tx.Preload("Field.Venue").Preload("Time").First(&fieldSchedule, fieldSchedule.ID).Error
+	})
+	if err != nil {
+		if errors.Is(err, errFieldSchedule.ErrFieldScheduleNotFound) ||
+			errors.Is(err, errFieldSchedule.ErrFieldScheduleIsExist) ||
+			errors.Is(err, errField.ErrFieldNotFound) {
+			return nil, errWrap.WrapError(err)
+		}
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal restore data field schedule:", err)
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	fmt.Println("✅ [INFO-REPOSITORIES] Berhasil restore data field schedule dengan UUID:", uuid)
+	return &fieldSchedule, nil
+}
+
+// listFilters mengubah query param list jadwal menjadi filter untuk fieldScheduleQuery.
+func (f *FieldScheduleRepository) listFilters(param *dto.FieldScheduleFilterParam) []util.QueryFilter {
+	filters := make([]util.QueryFilter, 0)
+	if param.Status != nil && *param.Status != "" {
+		status := constants.FieldScheduleStatusName(*param.Status).GetStatusInt()
+		filters = append(filters, util.QueryFilter{Key: "status", Operator: util.FilterEqual, Value: status})
+	}
+	if param.FieldID != nil && *param.FieldID != "" {
+		fieldIDs := f.db.Model(&models.Field{}).Select("id").Where("uuid = ?", *param.FieldID)
+		filters = append(filters, util.QueryFilter{Key: "fieldID", Operator: util.FilterIn, Value: fieldIDs})
+	}
+	if param.DateFrom != nil && *param.DateFrom != "" {
+		filters = append(filters, util.QueryFilter{Key: "date", Operator: util.FilterGreaterEqual, Value: *param.DateFrom})
+	}
+	if param.DateTo != nil && *param.DateTo != "" {
+		filters = append(filters, util.QueryFilter{Key: "date", Operator: util.FilterLessEqual, Value: *param.DateTo})
+	}
+	return filters
+}
+
 func (f *FieldScheduleRepository) FindAllByFieldIDAndDate(
 	ctx context.Context,
 	fieldID int, date string,
@@ -96,7 +305,7 @@ func (f *FieldScheduleRepository) FindAllByFieldIDAndDate(
 	fmt.Println("🔍 [DEBUG-REPOSITORIES] Mengambil semua data field")
 	err := f.db.
 		WithContext(ctx).
-		Preload("Field").
+		Preload("Field.Venue").
 		Preload("Time").
 		Where("field_id = ?", fieldID).
 		Where("date = ?", date).
@@ -115,13 +324,59 @@ func (f *FieldScheduleRepository) FindAllByFieldIDAndDate(
 	return fieldSchedules, nil
 }
 
+// FindAvailable mengambil slot Available di param.Date yang jamnya berada di dalam rentang
+// param.StartTime–param.EndTime (format HH:MM:SS), diurutkan per field lalu jam mulai.
+// Field, Venue dan Time di-join dalam satu query supaya tidak N+1.
+func (f *FieldScheduleRepository) FindAvailable(
+	ctx context.Context,
+	param *dto.FieldAvailabilityRequestParam,
+) ([]models.FieldSchedule, error) {
+	var fieldSchedules []models.FieldSchedule
+	fmt.Printf("🔍 [DEBUG-REPOSITORIES] Mencari slot available: %+v\n", param)
+	query := f.db.
+		WithContext(ctx).
+		Joins("Field").
+		Joins("Field.Venue").
+		Joins("Time").
+		Where("field_schedules.date = ?", param.Date).
+		Where("field_schedules.status = ?", constants.Available).
+		Where(`"Field".deleted_at IS NULL`).
+		Where(`"Time".start_time >= ?`, param.StartTime).
+		Where(
+			`(CASE WHEN "Time".end_time = ? THEN '24:00:00'::time ELSE "Time".end_time END) <= ?`,
+			constants.MidnightTime,
+			util.EndOfDayClock(param.EndTime),
+		)
+
+	if param.SportType != nil && *param.SportType != "" {
+		query = query.Where(`"Field".sport_type = ?`, *param.SportType)
+	}
+	if param.VenueID != nil && *param.VenueID != "" {
+		query = query.Where(`"Field__Venue".uuid = ?`, *param.VenueID)
+	}
+
+	err := query.
+		Order(`"Field".name asc`).
+		Order("field_schedules.field_id asc").
+		Order(`"Time".start_time asc`).
+		Find(&fieldSchedules).
+		Error
+	if err != nil {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal mencari slot available:", err)
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	fmt.Println("✅ [INFO-REPOSITORIES] Slot available ditemukan:", len(fieldSchedules))
+	return fieldSchedules, nil
+}
+
 func (f *FieldScheduleRepository) FindByUUID(ctx context.Context, uuid string) (*models.FieldSchedule, error) {
 	var fieldSchedules models.FieldSchedule
 	fmt.Println("🔍 [DEBUG-REPOSITORIES] Mengambil data field dengan UUID:", uuid)
 
 	err := f.db.
 		WithContext(ctx).
-		Preload("Field").
+		Preload("Field.Venue").
 		Preload("Time").
 		Where("uuid = ?", uuid).
 		First(&fieldSchedules).
@@ -171,10 +426,79 @@ func (f *FieldScheduleRepository) FindByDateAndTimeID(
 	return &fieldSchedules, nil
 }
 
+// CountByTimeID menghitung semua jadwal yang memakai time slot timeID, termasuk jadwal lama
+// dan yang sudah di-soft delete (histori booking tetap menunjuk ke slot ini).
+func (f *FieldScheduleRepository) CountByTimeID(ctx context.Context, timeID uint) (int64, error) {
+	var total int64
+	err := f.db.
+		WithContext(ctx).
+		Unscoped().
+		Model(&models.FieldSchedule{}).
+		Where("time_id = ?", timeID).
+		Count(&total).
+		Error
+	if err != nil {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal menghitung field schedule:", err)
+		return 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return total, nil
+}
+
+// CountBookedFromDateByFieldID menghitung jadwal Booked milik fieldID mulai tanggal date (YYYY-MM-DD).
+func (f *FieldScheduleRepository) CountBookedFromDateByFieldID(ctx context.Context, fieldID uint, date string) (int64, error) {
+	var total int64
+	err := f.db.
+		WithContext(ctx).
+		Model(&models.FieldSchedule{}).
+		Where("field_id = ?", fieldID).
+		Where("date >= ?", date).
+		Where("status = ?", constants.Booked).
+		Count(&total).
+		Error
+	if err != nil {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal menghitung jadwal booked:", err)
+		return 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return total, nil
+}
+
+// LockFromDateByFieldID mengunci (SELECT ... FOR UPDATE) semua jadwal fieldID mulai tanggal date.
+// Harus dipanggil di dalam transaksi; booking yang bersamaan menunggu sampai transaksi selesai.
+func (f *FieldScheduleRepository) LockFromDateByFieldID(ctx context.Context, fieldID uint, date string) error {
+	var ids []uint
+	err := f.db.
+		WithContext(ctx).
+		Model(&models.FieldSchedule{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("field_id = ?", fieldID).
+		Where("date >= ?", date).
+		Order("id asc").
+		Pluck("id", &ids).
+		Error
+	if err != nil {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal mengunci jadwal field:", err)
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	fmt.Println("🔒 [INFO-REPOSITORIES] Jadwal field dikunci:", len(ids))
+	return nil
+}
+
+// Create menyimpan jadwal per createBatchSize baris dalam satu transaksi: kalau satu batch gagal
+// (misal slot sudah ada), semua batch sebelumnya ikut di-rollback.
 func (f *FieldScheduleRepository) Create(ctx context.Context, req []models.FieldSchedule) error {
-	fmt.Println("🔍 [DEBUG-REPOSITORIES] Membuat data field schedule baru")
-	err := f.db.WithContext(ctx).Create(&req).Error
+	fmt.Println("🔍 [DEBUG-REPOSITORIES] Membuat data field schedule baru:", len(req))
+	err := f.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return tx.CreateInBatches(&req, createBatchSize).Error
+	})
 	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			// unique index (field_id, date, time_id) menolak jadwal yang sudah ada
+			fmt.Println("⚠️ [WARN-REPOSITORIES] Field schedule sudah ada:", err)
+			return errWrap.WrapError(errFieldSchedule.ErrFieldScheduleIsExist)
+		}
 		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal membuat data field:", err)
 		return errWrap.WrapError(errConstant.ErrSQLError)
 	}
@@ -183,23 +507,129 @@ func (f *FieldScheduleRepository) Create(ctx context.Context, req []models.Field
 	return nil
 }
 
+// CreateSkipExisting menyimpan jadwal dengan INSERT ... ON CONFLICT DO NOTHING pada
+// unique index (field_id, date, time_id) dan mengembalikan jumlah baris yang benar-benar dibuat.
+func (f *FieldScheduleRepository) CreateSkipExisting(ctx context.Context, req []models.FieldSchedule) (int64, error) {
+	fmt.Println("🔍 [DEBUG-REPOSITORIES] Membuat data field schedule baru (skip existing):", len(req))
+	result := f.db.
+		WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "field_id"}, {Name: "date"}, {Name: "time_id"}},
+			// Unique index-nya parsial (hanya slot yang belum dihapus), jadi predikatnya harus ikut disebut.
+			TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "deleted_at IS NULL"}}},
+			DoNothing:   true,
+		}).
+		CreateInBatches(&req, createBatchSize)
+	if result.Error != nil {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal membuat data field schedule:", result.Error)
+		return 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	fmt.Println("✅ [INFO-REPOSITORIES] Berhasil membuat data field schedule:", result.RowsAffected)
+	return result.RowsAffected, nil
+}
+
+// Update memindahkan jadwal ke req.Date, req.TimeID dan req.FieldID dalam satu transaksi: baris dikunci,
+// validate dijalankan terhadap data terkini, slot tujuan dicek bentrok (selain jadwal ini sendiri),
+// lalu event dari eventOf (kalau ada) ditulis bersama perubahannya.
+// Kalau jadwal Booked dipindah ke field lain, snapshot price_per_hour dihitung ulang dengan pricer (kalau ada).
 func (f *FieldScheduleRepository) Update(
 	ctx context.Context,
 	uuid string,
-	req *models.FieldSchedule) (*models.FieldSchedule, error) {
+	req *models.FieldSchedule,
+	validate FieldScheduleValidator,
+	eventOf FieldScheduleEventBuilder,
+	pricer FieldSchedulePricer,
+) (*models.FieldSchedule, error) {
+	var fieldSchedule *models.FieldSchedule
+	err := f.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		fieldSchedule, err = f.lockOne(tx, uuid, validate)
+		if err != nil {
+			return err
+		}
+		previousFieldID := fieldSchedule.FieldID
 
-	fieldSchedule, err := f.FindByUUID(ctx, uuid)
-	if err != nil {
-		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal mengambil data field:", err)
-		return nil, errWrap.WrapError(errConstant.ErrSQLError)
-	}
+		// 🔍 Slot tujuan tidak boleh sudah dipakai jadwal lain yang masih aktif
+		var total int64
+		err = tx.
+			Model(&models.FieldSchedule{}).
+			Where("field_id = ?", req.FieldID).
+			Where("date = ?", req.Date.Format(time.DateOnly)).
+			Where("time_id = ?", req.TimeID).
+			Where("id <> ?", fieldSchedule.ID).
+			Count(&total).
+			Error
+		if err != nil {
+			fmt.Println("❌ [ERROR-REPOSITORIES] Gagal cek bentrok field schedule:", err)
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
 
-	fieldSchedule.Date = req.Date
-	fmt.Println("🔍 [DEBUG-REPOSITORIES] Memperbarui data field dengan date:", fieldSchedule.Date)
-	err = f.db.WithContext(ctx).Save(&fieldSchedule).Error
+		if total > 0 {
+			fmt.Println("⚠️ [WARN-REPOSITORIES] Slot tujuan sudah dipakai jadwal lain")
+			return errWrap.WrapError(errFieldSchedule.ErrFieldScheduleIsExist)
+		}
+
+		err = f.createEvent(tx, *fieldSchedule, eventOf)
+		if err != nil {
+			return err
+		}
+
+		fmt.Println("🔍 [DEBUG-REPOSITORIES] Memperbarui data field dengan date:", req.Date, "timeID:", req.TimeID, "fieldID:", req.FieldID)
+		err = tx.
+			Model(&models.FieldSchedule{}).
+			Where("id = ?", fieldSchedule.ID).
+			Updates(map[string]any{
+				"date":     req.Date,
+				"time_id":  req.TimeID,
+				"field_id": req.FieldID,
+			}).
+			Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrDuplicatedKey) {
+				fmt.Println("⚠️ [WARN-REPOSITORIES] Slot tujuan keburu dipakai jadwal lain:", err)
+				return errWrap.WrapError(errFieldSchedule.ErrFieldScheduleIsExist)
+			}
+			fmt.Println("❌ [ERROR-REPOSITORIES] Gagal memperbarui data field:", err)
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+
+		// 🔄 Muat ulang supaya Field dan Time di hasil sesuai data yang baru
+		err = tx.
+			Preload("Field.Venue").
+			Preload("Time").
+			Where("id = ?", fieldSchedule.ID).
+			First(fieldSchedule).
+			Error
+		if err != nil {
+			fmt.Println("❌ [ERROR-REPOSITORIES] Gagal mengambil data field schedule:", err)
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+
+		// 💰 Booking yang pindah field memakai harga field tujuan, bukan snapshot field lama
+		if pricer == nil || fieldSchedule.Status != constants.Booked || fieldSchedule.FieldID == previousFieldID {
+			return nil
+		}
+
+		price, err := pricer(*fieldSchedule)
+		if err != nil {
+			return err
+		}
+
+		err = tx.
+			Model(&models.FieldSchedule{}).
+			Where("id = ?", fieldSchedule.ID).
+			Update("price_per_hour", price).
+			Error
+		if err != nil {
+			fmt.Println("❌ [ERROR-REPOSITORIES] Gagal menyimpan snapshot harga:", err)
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		fieldSchedule.PricePerHour = &price
+		return nil
+	})
 	if err != nil {
-		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal memperbarui data field:", err)
-		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+		return nil, err
 	}
 
 	fmt.Println("✅ [INFO-REPOSITORIES] Berhasil memperbarui data field dengan date:", fieldSchedule.Date)
@@ -230,14 +660,480 @@ func (f *FieldScheduleRepository) UpdateStatus(
 	return nil
 }
 
-func (f *FieldScheduleRepository) Delete(ctx context.Context, uuid string) error {
-	fmt.Println("🔍 [DEBUG-REPOSITORIES] Menghapus data field dengan UUID:", uuid)
-	err := f.db.WithContext(ctx).Where("uuid = ?", uuid).Delete(&models.FieldSchedule{}).Error
+// FieldScheduleValidator dipanggil untuk setiap jadwal yang sudah dikunci sebelum status diubah.
+// Kalau validator mengembalikan error, seluruh batch dibatalkan.
+type FieldScheduleValidator func(models.FieldSchedule) error
+
+// FieldScheduleEventBuilder membuat event untuk jadwal yang sudah dikunci (Field dan Time sudah di-preload).
+// Return nil artinya perubahan tersebut tidak perlu dicatat sebagai event.
+type FieldScheduleEventBuilder func(models.FieldSchedule) *models.FieldScheduleEvent
+
+// FieldSchedulePricer menghitung harga efektif per jam untuk jadwal (Field dan Time sudah di-preload).
+type FieldSchedulePricer func(models.FieldSchedule) (int, error)
+
+// UpdateStatusInBatch mengubah status banyak jadwal sekaligus dalam satu transaksi.
+// Semua baris dikunci dengan SELECT ... FOR UPDATE supaya dua request booking
+// yang bersamaan tidak bisa mengambil slot yang sama.
+func (f *FieldScheduleRepository) UpdateStatusInBatch(
+	ctx context.Context,
+	status constants.FieldScheduleStatus,
+	uuids []string,
+	validate FieldScheduleValidator,
+) error {
+	fmt.Println("🔍 [DEBUG-REPOSITORIES] Update status batch:", uuids, "status:", status)
+
+	// slot yang sebelumnya di-hold otomatis dilepas hold-nya
+	values := map[string]any{
+		"status":     status,
+		"held_by":    nil,
+		"held_until": nil,
+	}
+	if status == constants.Available {
+		values["price_per_hour"] = nil
+	}
+
+	return f.updateInBatch(ctx, uuids, validate, values, nil)
+}
+
+// BookInBatch mengubah banyak jadwal menjadi Booked dalam satu transaksi dan menyimpan
+// snapshot harga efektif tiap jadwal (dihitung oleh pricer) di kolom price_per_hour.
+func (f *FieldScheduleRepository) BookInBatch(
+	ctx context.Context,
+	uuids []string,
+	validate FieldScheduleValidator,
+	pricer FieldSchedulePricer,
+) error {
+	fmt.Println("🔍 [DEBUG-REPOSITORIES] Book batch:", uuids)
+
+	return f.updateInBatch(ctx, uuids, validate, map[string]any{
+		"status":     constants.Booked,
+		"held_by":    nil,
+		"held_until": nil,
+	}, func(tx *gorm.DB, fieldSchedules []models.FieldSchedule) error {
+		ids := make([]uint, 0, len(fieldSchedules))
+		for _, item := range fieldSchedules {
+			ids = append(ids, item.ID)
+		}
+
+		// 📝 Catatan:
+		// Baris sudah dikunci oleh updateInBatch, query ini cuma untuk preload Field & Time.
+		var bookedSchedules []models.FieldSchedule
+		err := tx.Preload("Field").Preload("Time").Where("id IN ?", ids).Find(&bookedSchedules).Error
+		if err != nil {
+			fmt.Println("❌ [ERROR-REPOSITORIES] Gagal mengambil data field schedule:", err)
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+
+		for _, item := range bookedSchedules {
+			price, err := pricer(item)
+			if err != nil {
+				return err
+			}
+
+			err = tx.
+				Model(&models.FieldSchedule{}).
+				Where("id = ?", item.ID).
+				Update("price_per_hour", price).
+				Error
+			if err != nil {
+				fmt.Println("❌ [ERROR-REPOSITORIES] Gagal menyimpan snapshot harga:", err)
+				return errWrap.WrapError(errConstant.ErrSQLError)
+			}
+		}
+		return nil
+	})
+}
+
+// HoldInBatch menahan slot untuk customer yang sedang checkout sampai heldUntil.
+func (f *FieldScheduleRepository) HoldInBatch(
+	ctx context.Context,
+	uuids []string,
+	userID uuid.UUID,
+	heldUntil time.Time,
+	validate FieldScheduleValidator,
+) error {
+	fmt.Println("🔍 [DEBUG-REPOSITORIES] Hold batch:", uuids, "userID:", userID, "heldUntil:", heldUntil)
+
+	return f.updateInBatch(ctx, uuids, validate, map[string]any{
+		"status":     constants.Held,
+		"held_by":    userID,
+		"held_until": heldUntil,
+	}, nil)
+}
+
+// ReleaseExpiredHolds mengembalikan semua hold yang sudah lewat dari now menjadi Available.
+func (f *FieldScheduleRepository) ReleaseExpiredHolds(ctx context.Context, now time.Time) (int64, error) {
+	result := f.db.
+		WithContext(ctx).
+		Model(&models.FieldSchedule{}).
+		Scopes(f.transitionScope(constants.Available, constants.Held)).
+		Where("held_until < ?", now).
+		Updates(map[string]any{
+			"status":     constants.Available,
+			"held_by":    nil,
+			"held_until": nil,
+		})
+	if result.Error != nil {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal release hold yang expired:", result.Error)
+		return 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return result.RowsAffected, nil
+}
+
+// ReleaseInBatch mengubah status slot (order dibatalkan/refund) dan mencatat
+// siapa yang melepas serta alasannya di tabel field_schedule_releases.
+func (f *FieldScheduleRepository) ReleaseInBatch(
+	ctx context.Context,
+	status constants.FieldScheduleStatus,
+	uuids []string,
+	reason string,
+	releasedBy string,
+	validate FieldScheduleValidator,
+) error {
+	fmt.Println("🔍 [DEBUG-REPOSITORIES] Release batch:", uuids, "releasedBy:", releasedBy, "reason:", reason)
+
+	// snapshot harga dipindah ke catatan release karena slot tidak lagi Booked
+	values := map[string]any{
+		"status": status,
+	}
+	if status != constants.Booked {
+		values["price_per_hour"] = nil
+	}
+
+	return f.updateInBatch(ctx, uuids, validate, values, func(tx *gorm.DB, fieldSchedules []models.FieldSchedule) error {
+		releases := make([]models.FieldScheduleRelease, 0, len(fieldSchedules))
+		for _, item := range fieldSchedules {
+			releases = append(releases, models.FieldScheduleRelease{
+				UUID:            uuid.New(),
+				FieldScheduleID: item.ID,
+				PreviousStatus:  item.Status,
+				PricePerHour:    item.PricePerHour,
+				Reason:          reason,
+				ReleasedBy:      releasedBy,
+			})
+		}
+
+		err := tx.Create(&releases).Error
+		if err != nil {
+			fmt.Println("❌ [ERROR-REPOSITORIES] Gagal mencatat release field schedule:", err)
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		return nil
+	})
+}
+
+// updateInBatch mengunci semua jadwal, menjalankan validate untuk tiap jadwal,
+// meng-update kolom values, lalu menjalankan afterUpdate (opsional) dalam transaksi yang sama.
+func (f *FieldScheduleRepository) updateInBatch(
+	ctx context.Context,
+	uuids []string,
+	validate FieldScheduleValidator,
+	values map[string]any,
+	afterUpdate func(*gorm.DB, []models.FieldSchedule) error,
+) error {
+	return f.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 🔒 Kunci baris berurutan berdasarkan id supaya tidak deadlock
+		fieldSchedules, err := f.lockByUUIDs(tx, uuids)
+		if err != nil {
+			return err
+		}
+
+		ids := make([]uint, 0, len(fieldSchedules))
+		for _, item := range fieldSchedules {
+			if validate != nil {
+				err = validate(item)
+				if err != nil {
+					fmt.Println("⚠️ [WARN-REPOSITORIES] Validasi field schedule gagal:", item.UUID, err)
+					return errWrap.WrapError(err)
+				}
+			}
+			ids = append(ids, item.ID)
+		}
+
+		err = tx.
+			Model(&models.FieldSchedule{}).
+			Where("id IN ?", ids).
+			Updates(values).
+			Error
+		if err != nil {
+			fmt.Println("❌ [ERROR-REPOSITORIES] Gagal update field schedule:", err)
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+
+		if afterUpdate != nil {
+			err = afterUpdate(tx, fieldSchedules)
+			if err != nil {
+				return err
+			}
+		}
+
+		fmt.Println("✅ [INFO-REPOSITORIES] Berhasil update field schedule batch:", len(ids))
+		return nil
+	})
+}
+
+// lockByUUIDs mengambil jadwal dengan SELECT ... FOR UPDATE di dalam transaksi tx
+// dan memastikan semua UUID yang diminta ditemukan.
+func (f *FieldScheduleRepository) lockByUUIDs(tx *gorm.DB, uuids []string) ([]models.FieldSchedule, error) {
+	var fieldSchedules []models.FieldSchedule
+	err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("uuid IN ?", uuids).
+		Order("id asc").
+		Find(&fieldSchedules).
+		Error
 	if err != nil {
-		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal menghapus data field:", err)
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal mengunci data field schedule:", err)
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	if len(fieldSchedules) != len(uuids) {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Sebagian field schedule tidak ditemukan")
+		return nil, errWrap.WrapError(errFieldSchedule.ErrFieldScheduleNotFound)
+	}
+
+	return fieldSchedules, nil
+}
+
+// lockOne mengunci satu jadwal, memuat Field dan Time-nya, lalu menjalankan validate (opsional).
+func (f *FieldScheduleRepository) lockOne(
+	tx *gorm.DB,
+	uuid string,
+	validate FieldScheduleValidator,
+) (*models.FieldSchedule, error) {
+	fieldSchedules, err := f.lockByUUIDs(tx, []string{uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	fieldSchedule := fieldSchedules[0]
+	err = tx.
+		Preload("Field").
+		Preload("Time").
+		Where("id = ?", fieldSchedule.ID).
+		First(&fieldSchedule).
+		Error
+	if err != nil {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal mengambil data field schedule:", err)
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	if validate != nil {
+		err = validate(fieldSchedule)
+		if err != nil {
+			fmt.Println("⚠️ [WARN-REPOSITORIES] Validasi field schedule gagal:", fieldSchedule.UUID, err)
+			return nil, errWrap.WrapError(err)
+		}
+	}
+
+	return &fieldSchedule, nil
+}
+
+// createEvent menulis event dari eventOf di transaksi tx. Tidak melakukan apa-apa kalau eventOf nil
+// atau tidak menghasilkan event.
+func (f *FieldScheduleRepository) createEvent(
+	tx *gorm.DB,
+	fieldSchedule models.FieldSchedule,
+	eventOf FieldScheduleEventBuilder,
+) error {
+	if eventOf == nil {
+		return nil
+	}
+
+	event := eventOf(fieldSchedule)
+	if event == nil {
+		return nil
+	}
+
+	err := tx.Create(event).Error
+	if err != nil {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal menyimpan field schedule event:", err)
 		return errWrap.WrapError(errConstant.ErrSQLError)
 	}
 
+	fmt.Println("📣 [INFO-REPOSITORIES] Field schedule event dibuat:", event.EventType, fieldSchedule.UUID)
+	return nil
+}
+
+// transitionScope membatasi query ke slot berstatus salah satu dari fromStatuses yang menurut
+// state machine boleh pindah ke to. Dipakai update massal yang tidak lewat validator.
+func (f *FieldScheduleRepository) transitionScope(
+	to constants.FieldScheduleStatus,
+	fromStatuses ...constants.FieldScheduleStatus,
+) func(*gorm.DB) *gorm.DB {
+	allowed := make([]constants.FieldScheduleStatus, 0, len(fromStatuses))
+	for _, status := range fromStatuses {
+		if status.CanTransitionTo(to) {
+			allowed = append(allowed, status)
+		}
+	}
+
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("status IN ?", allowed)
+	}
+}
+
+// closureScope membatasi query ke slot yang masuk rentang tanggal dan field dari closure.
+func (f *FieldScheduleRepository) closureScope(closure *models.Closure) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		db = db.Where("date BETWEEN ? AND ?", closure.StartDate, closure.EndDate)
+		if len(closure.FieldIDs) > 0 {
+			db = db.Where("field_id IN (SELECT id FROM fields WHERE uuid::text IN ?)", []string(closure.FieldIDs))
+		}
+		return db
+	}
+}
+
+// BlockByClosure memblokir slot yang sudah ter-generate di rentang closure.
+// Slot dengan status fromStatuses diubah menjadi Blocked, sedangkan slot Booked tidak dihapus
+// atau diubah statusnya tapi ditandai needs_follow_up supaya bisa ditindaklanjuti admin.
+// Mengembalikan jumlah slot yang diblokir dan jumlah booking yang ditandai.
+func (f *FieldScheduleRepository) BlockByClosure(
+	ctx context.Context,
+	closure *models.Closure,
+	fromStatuses []constants.FieldScheduleStatus,
+) (int64, int64, error) {
+	fmt.Println("🔍 [DEBUG-REPOSITORIES] Block field schedule oleh closure:", closure.UUID)
+
+	var blocked, flagged int64
+	err := f.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.
+			Model(&models.FieldSchedule{}).
+			Scopes(f.closureScope(closure), f.transitionScope(constants.Blocked, fromStatuses...)).
+			Updates(map[string]any{
+				"status":     constants.Blocked,
+				"closure_id": closure.ID,
+				"held_by":    nil,
+				"held_until": nil,
+			})
+		if result.Error != nil {
+			fmt.Println("❌ [ERROR-REPOSITORIES] Gagal block field schedule:", result.Error)
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		blocked = result.RowsAffected
+
+		result = tx.
+			Model(&models.FieldSchedule{}).
+			Scopes(f.closureScope(closure)).
+			Where("status = ?", constants.Booked).
+			Updates(map[string]any{
+				"closure_id":      closure.ID,
+				"needs_follow_up": true,
+			})
+		if result.Error != nil {
+			fmt.Println("❌ [ERROR-REPOSITORIES] Gagal menandai booking yang terdampak closure:", result.Error)
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		flagged = result.RowsAffected
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	fmt.Println("✅ [INFO-REPOSITORIES] Closure selesai diterapkan, blocked:", blocked, "flagged:", flagged)
+	return blocked, flagged, nil
+}
+
+// coveringClosureQuery mencari closure lain (selain closure ?) yang juga mencakup tanggal dan field
+// dari baris field_schedules yang sedang di-update. Closure dengan id terkecil dipilih.
+const coveringClosureQuery = "SELECT closures.id FROM closures " +
+	"WHERE closures.id <> ? " +
+	"AND field_schedules.date BETWEEN closures.start_date AND closures.end_date " +
+	"AND (cardinality(closures.field_ids) = 0 " +
+	"OR (SELECT fields.uuid::text FROM fields WHERE fields.id = field_schedules.field_id) = ANY(closures.field_ids)) " +
+	"ORDER BY closures.id ASC LIMIT 1"
+
+// UnblockByClosure mengembalikan slot Blocked milik closure menjadi Available dan
+// menghapus tanda follow-up pada booking yang sebelumnya terdampak.
+// Slot yang masih tercakup closure lain yang aktif tidak dibuka, tapi dipindah ke closure tersebut.
+func (f *FieldScheduleRepository) UnblockByClosure(ctx context.Context, closureID uint) (int64, error) {
+	fmt.Println("🔍 [DEBUG-REPOSITORIES] Unblock field schedule oleh closure:", closureID)
+
+	var unblocked int64
+	err := f.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 📝 Catatan:
+		// BlockByClosure menimpa closure_id, jadi slot yang beririsan dengan beberapa closure
+		// hanya tercatat di salah satunya. Pindahkan dulu ke closure lain yang masih mencakupnya.
+		result := tx.
+			Model(&models.FieldSchedule{}).
+			Where("closure_id = ?", closureID).
+			Where("EXISTS ("+coveringClosureQuery+")", closureID).
+			Update("closure_id", gorm.Expr("("+coveringClosureQuery+")", closureID))
+		if result.Error != nil {
+			fmt.Println("❌ [ERROR-REPOSITORIES] Gagal memindahkan field schedule ke closure lain:", result.Error)
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		fmt.Println("🔁 [INFO-REPOSITORIES] Field schedule dipindah ke closure lain:", result.RowsAffected)
+
+		result = tx.
+			Model(&models.FieldSchedule{}).
+			Scopes(f.transitionScope(constants.Available, constants.Blocked)).
+			Where("closure_id = ?", closureID).
+			Updates(map[string]any{
+				"status":     constants.Available,
+				"closure_id": nil,
+			})
+		if result.Error != nil {
+			fmt.Println("❌ [ERROR-REPOSITORIES] Gagal unblock field schedule:", result.Error)
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		unblocked = result.RowsAffected
+
+		err := tx.
+			Model(&models.FieldSchedule{}).
+			Where("closure_id = ?", closureID).
+			Updates(map[string]any{
+				"closure_id":      nil,
+				"needs_follow_up": false,
+			}).
+			Error
+		if err != nil {
+			fmt.Println("❌ [ERROR-REPOSITORIES] Gagal menghapus tanda follow-up:", err)
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return unblocked, nil
+}
+
+// Delete melakukan soft delete jadwal dalam satu transaksi dengan pola yang sama seperti Update:
+// kunci baris, validate, tulis event (kalau ada), lalu hapus.
+func (f *FieldScheduleRepository) Delete(
+	ctx context.Context,
+	uuid string,
+	validate FieldScheduleValidator,
+	eventOf FieldScheduleEventBuilder,
+) error {
+	fmt.Println("🔍 [DEBUG-REPOSITORIES] Menghapus data field dengan UUID:", uuid)
+	err := f.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		fieldSchedule, err := f.lockOne(tx, uuid, validate)
+		if err != nil {
+			return err
+		}
+
+		err = f.createEvent(tx, *fieldSchedule, eventOf)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("id = ?", fieldSchedule.ID).Delete(&models.FieldSchedule{}).Error
+		if err != nil {
+			fmt.Println("❌ [ERROR-REPOSITORIES] Gagal menghapus data field:", err)
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
 	fmt.Println("✅ [INFO-REPOSITORIES] Berhasil menghapus data field dengan UUID:", uuid)
 	return nil
 }
@@ -0,0 +1,47 @@
+package repositories
+
+import (
+	"context"
+	errWrap "field-service/common/error"
+	errConstant "field-service/constants/error"
+	"field-service/domain/models"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type FieldScheduleEventRepository struct {
+	db *gorm.DB
+}
+
+type IFieldScheduleEventRepository interface {
+	FindAfterID(context.Context, uint, int) ([]models.FieldScheduleEvent, error)
+}
+
+func NewFieldScheduleEventRepository(db *gorm.DB) IFieldScheduleEventRepository {
+	return &FieldScheduleEventRepository{db: db}
+}
+
+// FindAfterID mengambil event dengan ID lebih besar dari afterID, urut ID naik.
+// Consumer menyimpan ID terakhir yang sudah diproses dan memakainya untuk request berikutnya.
+func (f *FieldScheduleEventRepository) FindAfterID(
+	ctx context.Context,
+	afterID uint,
+	limit int,
+) ([]models.FieldScheduleEvent, error) {
+	var events []models.FieldScheduleEvent
+	err := f.db.
+		WithContext(ctx).
+		Where("id > ?", afterID).
+		Order("id asc").
+		Limit(limit).
+		Find(&events).
+		Error
+	if err != nil {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal mengambil field schedule event:", err)
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	fmt.Println("✅ [INFO-REPOSITORIES] Berhasil mengambil field schedule event:", len(events))
+	return events, nil
+}
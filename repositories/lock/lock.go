@@ -0,0 +1,53 @@
+package repositories
+
+import (
+	"context"
+	errWrap "field-service/common/error"
+	errConstant "field-service/constants/error"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type LockRepository struct {
+	db *gorm.DB
+}
+
+type ILockRepository interface {
+	WithAdvisoryLock(context.Context, int64, func(context.Context) error) (bool, error)
+}
+
+func NewLockRepository(db *gorm.DB) ILockRepository {
+	return &LockRepository{db: db}
+}
+
+// WithAdvisoryLock menjalankan fn hanya jika pg_try_advisory_xact_lock(key) berhasil didapat.
+// Lock dipegang oleh transaksi yang tetap terbuka selama fn berjalan, jadi replica lain
+// yang mencoba key yang sama akan langsung mendapat false dan tidak menjalankan fn.
+func (l *LockRepository) WithAdvisoryLock(
+	ctx context.Context,
+	key int64,
+	fn func(context.Context) error,
+) (bool, error) {
+	acquired := false
+	err := l.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Raw("SELECT pg_try_advisory_xact_lock(?)", key).Scan(&acquired).Error
+		if err != nil {
+			fmt.Println("❌ [ERROR-REPOSITORIES] Gagal mengambil advisory lock:", err)
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+
+		if !acquired {
+			fmt.Println("⚠️ [WARN-REPOSITORIES] Advisory lock sedang dipegang proses lain:", key)
+			return nil
+		}
+
+		fmt.Println("🔒 [INFO-REPOSITORIES] Advisory lock didapat:", key)
+		return fn(ctx)
+	})
+	if err != nil {
+		return acquired, err
+	}
+
+	return acquired, nil
+}
@@ -0,0 +1,172 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	errWrap "field-service/common/error"
+	errConstant "field-service/constants/error"
+	errPricingRule "field-service/constants/error/pricingrule"
+	"field-service/domain/models"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type PricingRuleRepository struct {
+	db *gorm.DB
+}
+
+type IPricingRuleRepository interface {
+	FindAll(context.Context) ([]models.PricingRule, error)
+	FindByUUID(context.Context, string) (*models.PricingRule, error)
+	FindActiveByFieldIDAndDate(context.Context, uint, string) ([]models.PricingRule, error)
+	FindActiveByFieldIDsAndDate(context.Context, []uint, string) ([]models.PricingRule, error)
+	Create(context.Context, *models.PricingRule) (*models.PricingRule, error)
+	Update(context.Context, string, *models.PricingRule) (*models.PricingRule, error)
+	Delete(context.Context, string) error
+}
+
+func NewPricingRuleRepository(db *gorm.DB) IPricingRuleRepository {
+	return &PricingRuleRepository{db: db}
+}
+
+func (p *PricingRuleRepository) FindAll(ctx context.Context) ([]models.PricingRule, error) {
+	fmt.Println("🔍 [DEBUG-REPOSITORIES] Mengambil semua data pricing rule")
+	var rules []models.PricingRule
+	err := p.db.
+		WithContext(ctx).
+		Preload("Field").
+		Order("priority asc").
+		Order("id asc").
+		Find(&rules).
+		Error
+	if err != nil {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal mengambil data pricing rule:", err)
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	fmt.Println("✅ [INFO-REPOSITORIES] Berhasil mengambil data pricing rule:", len(rules))
+	return rules, nil
+}
+
+func (p *PricingRuleRepository) FindByUUID(ctx context.Context, uuid string) (*models.PricingRule, error) {
+	var rule models.PricingRule
+	err := p.db.WithContext(ctx).Preload("Field").Where("uuid = ?", uuid).First(&rule).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			fmt.Println("❌ [ERROR-REPOSITORIES] Data pricing rule tidak ditemukan")
+			return nil, errWrap.WrapError(errPricingRule.ErrPricingRuleNotFound)
+		}
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal mengambil data pricing rule:", err)
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return &rule, nil
+}
+
+// FindActiveByFieldIDAndDate mengambil rule aktif yang mungkin berlaku untuk fieldID di tanggal date,
+// diurutkan sesuai urutan evaluasi (priority kecil dulu). Kecocokan hari & jam dicek di pricing engine.
+func (p *PricingRuleRepository) FindActiveByFieldIDAndDate(
+	ctx context.Context,
+	fieldID uint,
+	date string,
+) ([]models.PricingRule, error) {
+	var rules []models.PricingRule
+	err := p.db.
+		WithContext(ctx).
+		Where("is_active = ?", true).
+		Where("field_id IS NULL OR field_id = ?", fieldID).
+		Where("date IS NULL OR date = ?", date).
+		Order("priority asc").
+		Order("id asc").
+		Find(&rules).
+		Error
+	if err != nil {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal mengambil data pricing rule:", err)
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return rules, nil
+}
+
+// FindActiveByFieldIDsAndDate seperti FindActiveByFieldIDAndDate tapi untuk banyak field sekaligus,
+// supaya pencarian lintas field cukup satu query. Urutan rule sama (priority kecil dulu).
+func (p *PricingRuleRepository) FindActiveByFieldIDsAndDate(
+	ctx context.Context,
+	fieldIDs []uint,
+	date string,
+) ([]models.PricingRule, error) {
+	var rules []models.PricingRule
+	err := p.db.
+		WithContext(ctx).
+		Where("is_active = ?", true).
+		Where("field_id IS NULL OR field_id IN ?", fieldIDs).
+		Where("date IS NULL OR date = ?", date).
+		Order("priority asc").
+		Order("id asc").
+		Find(&rules).
+		Error
+	if err != nil {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal mengambil data pricing rule:", err)
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return rules, nil
+}
+
+func (p *PricingRuleRepository) Create(ctx context.Context, req *models.PricingRule) (*models.PricingRule, error) {
+	req.UUID = uuid.New()
+	err := p.db.WithContext(ctx).Create(req).Error
+	if err != nil {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal membuat data pricing rule:", err)
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	fmt.Println("✅ [INFO-REPOSITORIES] Berhasil membuat data pricing rule:", req.UUID)
+	return req, nil
+}
+
+func (p *PricingRuleRepository) Update(
+	ctx context.Context,
+	uuid string,
+	req *models.PricingRule,
+) (*models.PricingRule, error) {
+	// 📝 Catatan:
+	// Pakai map supaya nilai kosong (nil, false, 0) tetap ikut ter-update.
+	err := p.db.
+		WithContext(ctx).
+		Model(&models.PricingRule{}).
+		Where("uuid = ?", uuid).
+		Updates(map[string]any{
+			"name":            req.Name,
+			"field_id":        req.FieldID,
+			"weekdays":        req.Weekdays,
+			"start_time":      req.StartTime,
+			"end_time":        req.EndTime,
+			"date":            req.Date,
+			"adjustment_type": req.AdjustmentType,
+			"value":           req.Value,
+			"priority":        req.Priority,
+			"is_active":       req.IsActive,
+		}).
+		Error
+	if err != nil {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal memperbarui data pricing rule:", err)
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	fmt.Println("✅ [INFO-REPOSITORIES] Berhasil memperbarui data pricing rule:", uuid)
+	return p.FindByUUID(ctx, uuid)
+}
+
+func (p *PricingRuleRepository) Delete(ctx context.Context, uuid string) error {
+	err := p.db.WithContext(ctx).Where("uuid = ?", uuid).Delete(&models.PricingRule{}).Error
+	if err != nil {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal menghapus data pricing rule:", err)
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	fmt.Println("✅ [INFO-REPOSITORIES] Berhasil menghapus data pricing rule:", uuid)
+	return nil
+}
@@ -1,9 +1,15 @@
 package repositories
 
 import (
+	"context"
+	closureRepositories "field-service/repositories/closure"
 	fieldRepositories "field-service/repositories/field"
 	fieldScheduleRepositories "field-service/repositories/fieldschedule"
+	fieldScheduleEventRepositories "field-service/repositories/fieldscheduleevent"
+	lockRepositories "field-service/repositories/lock"
+	pricingRuleRepositories "field-service/repositories/pricingrule"
 	timeRepositories "field-service/repositories/time"
+	venueRepositories "field-service/repositories/venue"
 
 	"gorm.io/gorm"
 )
@@ -15,7 +21,13 @@ type Registry struct {
 type IRepositoryRegistry interface {
 	GetField() fieldRepositories.IFieldRepository
 	GetFieldSchedule() fieldScheduleRepositories.IFieldScheduleRepository
+	GetFieldScheduleEvent() fieldScheduleEventRepositories.IFieldScheduleEventRepository
 	GetTime() timeRepositories.ITimeRepository
+	GetLock() lockRepositories.ILockRepository
+	GetClosure() closureRepositories.IClosureRepository
+	GetPricingRule() pricingRuleRepositories.IPricingRuleRepository
+	GetVenue() venueRepositories.IVenueRepository
+	WithTransaction(context.Context, func(IRepositoryRegistry) error) error
 }
 
 func NewRepositoryRegistry(db *gorm.DB) IRepositoryRegistry {
@@ -30,6 +42,35 @@ func (r *Registry) GetFieldSchedule() fieldScheduleRepositories.IFieldScheduleRe
 	return fieldScheduleRepositories.NewFieldScheduleRepository(r.db)
 }
 
+func (r *Registry) GetFieldScheduleEvent() fieldScheduleEventRepositories.IFieldScheduleEventRepository {
+	return fieldScheduleEventRepositories.NewFieldScheduleEventRepository(r.db)
+}
+
 func (r *Registry) GetTime() timeRepositories.ITimeRepository {
 	return timeRepositories.NewTimeRepository(r.db)
 }
+
+func (r *Registry) GetLock() lockRepositories.ILockRepository {
+	return lockRepositories.NewLockRepository(r.db)
+}
+
+func (r *Registry) GetClosure() closureRepositories.IClosureRepository {
+	return closureRepositories.NewClosureRepository(r.db)
+}
+
+func (r *Registry) GetPricingRule() pricingRuleRepositories.IPricingRuleRepository {
+	return pricingRuleRepositories.NewPricingRuleRepository(r.db)
+}
+
+func (r *Registry) GetVenue() venueRepositories.IVenueRepository {
+	return venueRepositories.NewVenueRepository(r.db)
+}
+
+// WithTransaction menjalankan fn dengan registry yang semua repository-nya terikat ke satu transaksi.
+// Kalau fn mengembalikan error, seluruh perubahan di-rollback; kalau tidak, di-commit.
+// Repository yang membuka transaksi sendiri di dalam fn otomatis memakai savepoint.
+func (r *Registry) WithTransaction(ctx context.Context, fn func(IRepositoryRegistry) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(&Registry{db: tx})
+	})
+}
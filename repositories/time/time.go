@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	errWrap "field-service/common/error"
+	"field-service/common/util"
+	"field-service/constants"
 	errConstant "field-service/constants/error"
 	errTime "field-service/constants/error/time"
 	"field-service/domain/models"
@@ -19,9 +21,13 @@ type TimeRepository struct {
 
 type ITimeRepository interface {
 	FindAll(context.Context) ([]models.Time, error)
+	FindCatalogByFieldID(context.Context, uint) ([]models.Time, error)
 	FindByUUID(context.Context, string) (*models.Time, error)
 	FindById(context.Context, int) (*models.Time, error)
+	FindOverlapping(context.Context, *uint, string, string, string) ([]models.Time, error)
 	Create(context.Context, *models.Time) (*models.Time, error)
+	Update(context.Context, string, *models.Time) (*models.Time, error)
+	Delete(context.Context, string) error
 }
 
 func NewTimeRepository(db *gorm.DB) ITimeRepository {
@@ -31,7 +37,7 @@ func NewTimeRepository(db *gorm.DB) ITimeRepository {
 func (t *TimeRepository) FindAll(ctx context.Context) ([]models.Time, error) {
 	fmt.Println("🔍 [DEBUG-REPOSITORIES] Mengambil semua data waktu")
 	var times []models.Time
-	err := t.db.WithContext(ctx).Find(&times).Error
+	err := t.db.WithContext(ctx).Preload("Field").Order("start_time asc").Find(&times).Error
 	if err != nil {
 		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal mengambil data waktu:", err)
 		return nil, errWrap.WrapError(errConstant.ErrSQLError)
@@ -43,7 +49,7 @@ func (t *TimeRepository) FindAll(ctx context.Context) ([]models.Time, error) {
 
 func (t *TimeRepository) FindByUUID(ctx context.Context, uuid string) (*models.Time, error) {
 	var time models.Time
-	err := t.db.WithContext(ctx).Where("uuid = ?", uuid).First(&time).Error
+	err := t.db.WithContext(ctx).Preload("Field").Where("uuid = ?", uuid).First(&time).Error
 	if err != nil {
 		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal mengambil data waktu:", err)
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -81,3 +87,107 @@ func (t *TimeRepository) Create(ctx context.Context, time *models.Time) (*models
 	fmt.Println("✅ [INFO-REPOSITORIES] Berhasil membuat data waktu:", time)
 	return time, nil
 }
+
+// FindCatalogByFieldID mengambil katalog waktu untuk field: slot milik field itu sendiri
+// kalau ada (override), kalau tidak ada pakai katalog global (field_id NULL).
+func (t *TimeRepository) FindCatalogByFieldID(ctx context.Context, fieldID uint) ([]models.Time, error) {
+	fmt.Println("🔍 [DEBUG-REPOSITORIES] Mengambil katalog waktu untuk field:", fieldID)
+	var times []models.Time
+	err := t.db.
+		WithContext(ctx).
+		Preload("Field").
+		Where("field_id = ?", fieldID).
+		Order("start_time asc").
+		Find(&times).
+		Error
+	if err != nil {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal mengambil data waktu:", err)
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	if len(times) > 0 {
+		return times, nil
+	}
+
+	err = t.db.
+		WithContext(ctx).
+		Where("field_id IS NULL").
+		Order("start_time asc").
+		Find(&times).
+		Error
+	if err != nil {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal mengambil data waktu:", err)
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return times, nil
+}
+
+// FindOverlapping mengambil time slot di katalog yang sama (fieldID, nil = global) yang beririsan
+// dengan rentang startTime - endTime. excludeUUID dipakai saat update supaya slot yang sedang
+// diubah tidak dianggap bentrok.
+func (t *TimeRepository) FindOverlapping(
+	ctx context.Context,
+	fieldID *uint,
+	startTime string,
+	endTime string,
+	excludeUUID string,
+) ([]models.Time, error) {
+	var times []models.Time
+	query := t.db.
+		WithContext(ctx).
+		Where("start_time < ?", util.EndOfDayClock(endTime)).
+		Where("(CASE WHEN end_time = ? THEN '24:00:00'::time ELSE end_time END) > ?", constants.MidnightTime, startTime)
+	if fieldID != nil {
+		query = query.Where("field_id = ?", *fieldID)
+	} else {
+		query = query.Where("field_id IS NULL")
+	}
+	if excludeUUID != "" {
+		query = query.Where("uuid <> ?", excludeUUID)
+	}
+
+	err := query.Find(&times).Error
+	if err != nil {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal cek overlap data waktu:", err)
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return times, nil
+}
+
+func (t *TimeRepository) Update(ctx context.Context, uuid string, req *models.Time) (*models.Time, error) {
+	fmt.Println("🔍 [DEBUG-REPOSITORIES] Memperbarui data waktu dengan UUID:", uuid)
+	err := t.db.
+		WithContext(ctx).
+		Model(&models.Time{}).
+		Where("uuid = ?", uuid).
+		Updates(map[string]any{
+			"start_time": req.StartTime,
+			"end_time":   req.EndTime,
+		}).
+		Error
+	if err != nil {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal memperbarui data waktu:", err)
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	fmt.Println("✅ [INFO-REPOSITORIES] Berhasil memperbarui data waktu dengan UUID:", uuid)
+	return t.FindByUUID(ctx, uuid)
+}
+
+func (t *TimeRepository) Delete(ctx context.Context, uuid string) error {
+	fmt.Println("🔍 [DEBUG-REPOSITORIES] Menghapus data waktu dengan UUID:", uuid)
+	err := t.db.WithContext(ctx).Where("uuid = ?", uuid).Delete(&models.Time{}).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			fmt.Println("⚠️ [WARN-REPOSITORIES] Data waktu masih dipakai jadwal:", uuid)
+			return errWrap.WrapError(errTime.ErrTimeInUse)
+		}
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal menghapus data waktu:", err)
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	fmt.Println("✅ [INFO-REPOSITORIES] Berhasil menghapus data waktu dengan UUID:", uuid)
+	return nil
+}
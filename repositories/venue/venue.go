@@ -0,0 +1,123 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	errWrap "field-service/common/error"
+	errConstant "field-service/constants/error"
+	errVenue "field-service/constants/error/venue"
+	"field-service/domain/models"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type VenueRepository struct {
+	db *gorm.DB
+}
+
+type IVenueRepository interface {
+	FindAll(context.Context) ([]models.Venue, error)
+	FindByUUID(context.Context, string) (*models.Venue, error)
+	CountFields(context.Context, uint) (int64, error)
+	Create(context.Context, *models.Venue) (*models.Venue, error)
+	Update(context.Context, string, *models.Venue) (*models.Venue, error)
+	Delete(context.Context, string) error
+}
+
+func NewVenueRepository(db *gorm.DB) IVenueRepository {
+	return &VenueRepository{db: db}
+}
+
+func (v *VenueRepository) FindAll(ctx context.Context) ([]models.Venue, error) {
+	fmt.Println("🔍 [DEBUG-REPOSITORIES] Mengambil semua data venue")
+	var venues []models.Venue
+	err := v.db.WithContext(ctx).Order("name asc").Find(&venues).Error
+	if err != nil {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal mengambil data venue:", err)
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	fmt.Println("✅ [INFO-REPOSITORIES] Berhasil mengambil data venue:", len(venues))
+	return venues, nil
+}
+
+func (v *VenueRepository) FindByUUID(ctx context.Context, uuid string) (*models.Venue, error) {
+	var venue models.Venue
+	err := v.db.WithContext(ctx).Where("uuid = ?", uuid).First(&venue).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			fmt.Println("❌ [ERROR-REPOSITORIES] Data venue tidak ditemukan")
+			return nil, errWrap.WrapError(errVenue.ErrVenueNotFound)
+		}
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal mengambil data venue:", err)
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	fmt.Println("✅ [INFO-REPOSITORIES] Berhasil mengambil data venue:", venue.UUID)
+	return &venue, nil
+}
+
+// CountFields menghitung jumlah field yang berada di venue venueID.
+func (v *VenueRepository) CountFields(ctx context.Context, venueID uint) (int64, error) {
+	var total int64
+	err := v.db.WithContext(ctx).Model(&models.Field{}).Where("venue_id = ?", venueID).Count(&total).Error
+	if err != nil {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal menghitung field di venue:", err)
+		return 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return total, nil
+}
+
+func (v *VenueRepository) Create(ctx context.Context, req *models.Venue) (*models.Venue, error) {
+	req.UUID = uuid.New()
+	err := v.db.WithContext(ctx).Create(req).Error
+	if err != nil {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal membuat data venue:", err)
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	fmt.Println("✅ [INFO-REPOSITORIES] Berhasil membuat data venue:", req.UUID)
+	return req, nil
+}
+
+func (v *VenueRepository) Update(ctx context.Context, uuid string, req *models.Venue) (*models.Venue, error) {
+	fmt.Println("🔍 [DEBUG-REPOSITORIES] Memperbarui data venue dengan UUID:", uuid)
+	err := v.db.
+		WithContext(ctx).
+		Model(&models.Venue{}).
+		Where("uuid = ?", uuid).
+		Updates(map[string]any{
+			"name":          req.Name,
+			"address":       req.Address,
+			"latitude":      req.Latitude,
+			"longitude":     req.Longitude,
+			"timezone":      req.Timezone,
+			"open_time":     req.OpenTime,
+			"close_time":    req.CloseTime,
+			"contact_phone": req.ContactPhone,
+			"contact_email": req.ContactEmail,
+		}).
+		Error
+	if err != nil {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal memperbarui data venue:", err)
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	fmt.Println("✅ [INFO-REPOSITORIES] Berhasil memperbarui data venue dengan UUID:", uuid)
+	return v.FindByUUID(ctx, uuid)
+}
+
+func (v *VenueRepository) Delete(ctx context.Context, uuid string) error {
+	fmt.Println("🔍 [DEBUG-REPOSITORIES] Menghapus data venue dengan UUID:", uuid)
+	err := v.db.WithContext(ctx).Where("uuid = ?", uuid).Delete(&models.Venue{}).Error
+	if err != nil {
+		fmt.Println("❌ [ERROR-REPOSITORIES] Gagal menghapus data venue:", err)
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	fmt.Println("✅ [INFO-REPOSITORIES] Berhasil menghapus data venue dengan UUID:", uuid)
+	return nil
+}
@@ -0,0 +1,46 @@
+package routes
+
+import (
+	"field-service/clients"
+	"field-service/constants"
+	"field-service/controllers"
+	"field-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ClosureRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+	client     clients.IClientRegistry
+}
+
+type IClosureRoute interface {
+	Run()
+}
+
+func NewClosureRoute(controller controllers.IControllerRegistry,
+	group *gin.RouterGroup, client clients.IClientRegistry) IClosureRoute {
+	return &ClosureRoute{
+		controller: controller,
+		group:      group,
+		client:     client,
+	}
+}
+
+func (cl *ClosureRoute) Run() {
+	group := cl.group.Group("/closure")
+	group.Use(middlewares.Authenticate())
+	group.GET("", middlewares.CheckRole([]string{
+		constants.Admin}, cl.client),
+		cl.controller.GetClosure().GetAll)
+	group.GET("/:uuid", middlewares.CheckRole([]string{
+		constants.Admin}, cl.client),
+		cl.controller.GetClosure().GetByUUID)
+	group.POST("", middlewares.CheckRole([]string{
+		constants.Admin}, cl.client),
+		cl.controller.GetClosure().Create)
+	group.DELETE("/:uuid", middlewares.CheckRole([]string{
+		constants.Admin}, cl.client),
+		cl.controller.GetClosure().Delete)
+}
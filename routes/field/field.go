@@ -75,4 +75,18 @@ func (f *FieldRoute) Run() {
 		constants.Admin,
 	}, f.client),
 		f.controller.GetField().Delete)
+
+	// 🗑️ [GET] Endpoint untuk melihat field yang sudah dihapus (trash)
+	// Hanya role Admin yang bisa mengakses endpoint ini
+	group.GET("/trash", middlewares.CheckRole([]string{
+		constants.Admin,
+	}, f.client),
+		f.controller.GetField().GetAllDeleted)
+
+	// ♻️ [PATCH] Endpoint untuk restore field yang sudah dihapus
+	// Hanya role Admin yang bisa mengakses endpoint ini
+	group.PATCH("/:uuid/restore", middlewares.CheckRole([]string{
+		constants.Admin,
+	}, f.client),
+		f.controller.GetField().Restore)
 }
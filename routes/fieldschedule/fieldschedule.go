@@ -36,8 +36,20 @@ func (f *FieldScheduleRoute) Run() {
 
 	// 🛣️ [GET] Endpoint untuk mendapatkan semua field schedule berdasarkan ID dan tanggal
 	group.GET("/lists/:uuid", middlewares.AuthenticateWithoutToken(), f.controller.GetFieldSchedule().GetAllByFieldIDAndDate)
+	// 🛣️ [GET] Endpoint untuk cari field yang masih punya slot kosong di tanggal + rentang jam tertentu
+	group.GET("/availability", middlewares.AuthenticateWithoutToken(), f.controller.GetFieldSchedule().SearchAvailability)
 	// 🛣️ [GET] Endpoint untuk update status fieldSchedule
 	group.PATCH("/status", middlewares.AuthenticateWithoutToken(), f.controller.GetFieldSchedule().UpdateStatus)
+	// 🛣️ [PATCH] Endpoint untuk booking beberapa jam berurutan (field + tanggal + jam mulai + durasi)
+	group.PATCH("/status/range", middlewares.AuthenticateWithoutToken(), f.controller.GetFieldSchedule().BookRange)
+	// 🛣️ [PATCH] Endpoint untuk hold slot selama customer checkout
+	group.PATCH("/hold", middlewares.AuthenticateWithoutToken(), f.controller.GetFieldSchedule().Hold)
+	// 🛣️ [PATCH] Endpoint untuk melepas hold slot (checkout dibatalkan)
+	group.PATCH("/hold/release", middlewares.AuthenticateWithoutToken(), f.controller.GetFieldSchedule().ReleaseHold)
+	// 🛣️ [PATCH] Endpoint untuk melepas slot yang sudah dibooking (order dibatalkan/refund)
+	group.PATCH("/release", middlewares.AuthenticateWithoutToken(), f.controller.GetFieldSchedule().Release)
+	// 🛣️ [GET] Endpoint feed event booking yang dipindah/dibatalkan paksa oleh admin (dibaca service order/notifikasi)
+	group.GET("/events", middlewares.AuthenticateWithoutToken(), f.controller.GetFieldSchedule().GetEvents)
 
 	// 🔐 Middleware wajib login untuk semua route di bawah ini
 	group.Use(middlewares.Authenticate())
@@ -52,6 +64,13 @@ func (f *FieldScheduleRoute) Run() {
 	}, f.client),
 		f.controller.GetFieldSchedule().GetAllWithPagination)
 
+	// 🛣️ [GET] Endpoint list field schedule dengan cursor (keyset) pagination, untuk tabel jadwal yang besar
+	group.GET("/cursor", middlewares.CheckRole([]string{
+		constants.Admin,
+		constants.Customer,
+	}, f.client),
+		f.controller.GetFieldSchedule().GetAllWithCursor)
+
 	// 🛣️ [GET] Endpoint untuk mendapatkan field schedule berdasarkan UUID
 	group.GET("/:uuid", middlewares.CheckRole([]string{
 		constants.Admin,
@@ -73,6 +92,18 @@ func (f *FieldScheduleRoute) Run() {
 	}, f.client),
 		f.controller.GetFieldSchedule().GenerateScheduleForOneMonth)
 
+	// 🛣️ [POST] Endpoint untuk generate schedule dengan rentang tanggal dan template per hari
+	group.POST("/generate", middlewares.CheckRole([]string{
+		constants.Admin,
+	}, f.client),
+		f.controller.GetFieldSchedule().Generate)
+
+	// 🛣️ [PATCH] Endpoint untuk admin mengubah status jadwal (Blocked, Maintenance, Cancelled, Available)
+	group.PATCH("/status/admin", middlewares.CheckRole([]string{
+		constants.Admin,
+	}, f.client),
+		f.controller.GetFieldSchedule().ChangeStatus)
+
 	// 🛣️ [PUT] Endpoint untuk upate data berdasarkan uuid
 	// Middleware CheckRole untuk memeriksa role user
 	// Hanya role Admin yang bisa mengakses endpoint ini
@@ -86,4 +117,16 @@ func (f *FieldScheduleRoute) Run() {
 		constants.Admin,
 	}, f.client),
 		f.controller.GetFieldSchedule().Delete)
+
+	// 🗑️ [GET] Endpoint untuk melihat field schedule yang sudah dihapus (trash, Hanya admin)
+	group.GET("/trash", middlewares.CheckRole([]string{
+		constants.Admin,
+	}, f.client),
+		f.controller.GetFieldSchedule().GetAllDeletedWithPagination)
+
+	// ♻️ [PATCH] Endpoint untuk restore field schedule yang sudah dihapus (Hanya admin)
+	group.PATCH("/:uuid/restore", middlewares.CheckRole([]string{
+		constants.Admin,
+	}, f.client),
+		f.controller.GetFieldSchedule().Restore)
 }
@@ -0,0 +1,49 @@
+package routes
+
+import (
+	"field-service/clients"
+	"field-service/constants"
+	"field-service/controllers"
+	"field-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PricingRuleRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+	client     clients.IClientRegistry
+}
+
+type IPricingRuleRoute interface {
+	Run()
+}
+
+func NewPricingRuleRoute(controller controllers.IControllerRegistry,
+	group *gin.RouterGroup, client clients.IClientRegistry) IPricingRuleRoute {
+	return &PricingRuleRoute{
+		controller: controller,
+		group:      group,
+		client:     client,
+	}
+}
+
+func (p *PricingRuleRoute) Run() {
+	group := p.group.Group("/pricing-rule")
+	group.Use(middlewares.Authenticate())
+	group.GET("", middlewares.CheckRole([]string{
+		constants.Admin}, p.client),
+		p.controller.GetPricingRule().GetAll)
+	group.GET("/:uuid", middlewares.CheckRole([]string{
+		constants.Admin}, p.client),
+		p.controller.GetPricingRule().GetByUUID)
+	group.POST("", middlewares.CheckRole([]string{
+		constants.Admin}, p.client),
+		p.controller.GetPricingRule().Create)
+	group.PUT("/:uuid", middlewares.CheckRole([]string{
+		constants.Admin}, p.client),
+		p.controller.GetPricingRule().Update)
+	group.DELETE("/:uuid", middlewares.CheckRole([]string{
+		constants.Admin}, p.client),
+		p.controller.GetPricingRule().Delete)
+}
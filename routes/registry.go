@@ -3,9 +3,12 @@ package routes
 import (
 	"field-service/clients"
 	"field-service/controllers"
+	routesClosure "field-service/routes/closure"
 	routesField "field-service/routes/field"
 	routesFieldSchedule "field-service/routes/fieldschedule"
+	routesPricingRule "field-service/routes/pricingrule"
 	routesTime "field-service/routes/time"
+	routesVenue "field-service/routes/venue"
 
 	"github.com/gin-gonic/gin"
 )
@@ -43,7 +46,22 @@ func (r *Registry) timeRoute() routesTime.ITimeRoute {
 	return routesTime.NewTimeRoute(r.controller, r.group, r.client)
 }
 
+func (r *Registry) closureRoute() routesClosure.IClosureRoute {
+	return routesClosure.NewClosureRoute(r.controller, r.group, r.client)
+}
+
+func (r *Registry) pricingRuleRoute() routesPricingRule.IPricingRuleRoute {
+	return routesPricingRule.NewPricingRuleRoute(r.controller, r.group, r.client)
+}
+
+func (r *Registry) venueRoute() routesVenue.IVenueRoute {
+	return routesVenue.NewVenueRoute(r.controller, r.group, r.client)
+}
+
 func (r *Registry) Serve() {
+	// 🛣️ Endpoint untuk venue
+	r.venueRoute().Run()
+
 	// 🛣️ Endpoint untuk field
 	r.fieldRoute().Run()
 
@@ -52,4 +70,10 @@ func (r *Registry) Serve() {
 
 	// 🛣️ Endpoint untuk time
 	r.timeRoute().Run()
+
+	// 🛣️ Endpoint untuk closure (hari libur/penutupan)
+	r.closureRoute().Run()
+
+	// 🛣️ Endpoint untuk pricing rule (harga dinamis)
+	r.pricingRuleRoute().Run()
 }
@@ -0,0 +1,55 @@
+package routes
+
+import (
+	"field-service/clients"
+	"field-service/constants"
+	"field-service/controllers"
+	"field-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type VenueRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+	client     clients.IClientRegistry
+}
+
+type IVenueRoute interface {
+	Run()
+}
+
+func NewVenueRoute(controller controllers.IControllerRegistry,
+	group *gin.RouterGroup, client clients.IClientRegistry) IVenueRoute {
+	return &VenueRoute{
+		controller: controller,
+		group:      group,
+		client:     client,
+	}
+}
+
+func (v *VenueRoute) Run() {
+	// 🛣️ Subgroup dengan prefix /venue (sehingga endpoint jadi /venue/...)
+	group := v.group.Group("/venue")
+
+	// 🛣️ [GET] Endpoint list & detail venue, boleh tanpa login
+	group.GET("", middlewares.AuthenticateWithoutToken(), v.controller.GetVenue().GetAll)
+	group.GET("/:uuid", middlewares.AuthenticateWithoutToken(), v.controller.GetVenue().GetByUUID)
+
+	// 🔐 Middleware wajib login untuk semua route di bawah ini
+	group.Use(middlewares.Authenticate())
+
+	// ➕ [POST] / 🛣️ [PUT] / [DELETE] hanya untuk Admin
+	group.POST("", middlewares.CheckRole([]string{
+		constants.Admin,
+	}, v.client),
+		v.controller.GetVenue().Create)
+	group.PUT("/:uuid", middlewares.CheckRole([]string{
+		constants.Admin,
+	}, v.client),
+		v.controller.GetVenue().Update)
+	group.DELETE("/:uuid", middlewares.CheckRole([]string{
+		constants.Admin,
+	}, v.client),
+		v.controller.GetVenue().Delete)
+}
@@ -0,0 +1,179 @@
+package services
+
+import (
+	"context"
+	"field-service/constants"
+	errClosure "field-service/constants/error/closure"
+	"field-service/domain/dto"
+	"field-service/domain/models"
+	"field-service/repositories"
+	"fmt"
+	"time"
+)
+
+type ClosureService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+type IClosureService interface {
+	GetAll(context.Context) ([]dto.ClosureResponse, error)
+	GetByUUID(context.Context, string) (*dto.ClosureResponse, error)
+	Create(context.Context, *dto.ClosureRequest) (*dto.ClosureResponse, error)
+	Delete(context.Context, string) error
+}
+
+func NewClosureService(repository repositories.IRepositoryRegistry) IClosureService {
+	return &ClosureService{repository: repository}
+}
+
+func (c *ClosureService) toResponse(closure *models.Closure) dto.ClosureResponse {
+	return dto.ClosureResponse{
+		UUID:      closure.UUID,
+		Reason:    closure.Reason,
+		StartDate: closure.StartDate.Format(time.DateOnly),
+		EndDate:   closure.EndDate.Format(time.DateOnly),
+		FieldIDs:  closure.FieldIDs,
+		CreatedAt: closure.CreatedAt,
+		UpdatedAt: closure.UpdatedAt,
+	}
+}
+
+func (c *ClosureService) GetAll(ctx context.Context) ([]dto.ClosureResponse, error) {
+	fmt.Println("🚀 [DEBUG-CLOSURE-SERVICE] Mulai GetAll")
+	closures, err := c.repository.GetClosure().FindAll(ctx)
+	if err != nil {
+		fmt.Println("❌ [ERROR-CLOSURE-SERVICE] Gagal mengambil data closure:", err)
+		return nil, err
+	}
+
+	closureResults := make([]dto.ClosureResponse, 0, len(closures))
+	for _, closure := range closures {
+		closureResults = append(closureResults, c.toResponse(&closure))
+	}
+
+	fmt.Println("🏁 [INFO-CLOSURE-SERVICE] GetAll selesai:", len(closureResults))
+	return closureResults, nil
+}
+
+func (c *ClosureService) GetByUUID(ctx context.Context, uuid string) (*dto.ClosureResponse, error) {
+	fmt.Println("🔍 [DEBUG-CLOSURE-SERVICE] GetByUUID:", uuid)
+	closure, err := c.repository.GetClosure().FindByUUID(ctx, uuid)
+	if err != nil {
+		fmt.Println("❌ [ERROR-CLOSURE-SERVICE] Gagal mengambil data closure:", err)
+		return nil, err
+	}
+
+	response := c.toResponse(closure)
+	return &response, nil
+}
+
+func (c *ClosureService) Create(ctx context.Context, request *dto.ClosureRequest) (*dto.ClosureResponse, error) {
+	// 🚀 Step 1: Mulai proses & debug input
+	fmt.Println("🚀 [DEBUG-CLOSURE-SERVICE] Create dimulai")
+	fmt.Printf("📥 [DEBUG-CLOSURE-SERVICE] Input: %+v\n", request)
+
+	// ✅ Step 2: Parse dan validasi rentang tanggal
+	startDate, err := time.Parse(time.DateOnly, request.StartDate)
+	if err != nil {
+		return nil, errClosure.ErrClosureInvalidDateRange
+	}
+
+	endDate, err := time.Parse(time.DateOnly, request.EndDate)
+	if err != nil || endDate.Before(startDate) {
+		return nil, errClosure.ErrClosureInvalidDateRange
+	}
+
+	// ✅ Step 3: Pastikan semua field yang disebut memang ada (kosong = semua field)
+	fieldIDs := make([]string, 0, len(request.FieldIDs))
+	for _, fieldID := range request.FieldIDs {
+		field, err := c.repository.GetField().FindByUUID(ctx, fieldID)
+		if err != nil {
+			fmt.Printf("❌ [ERROR-CLOSURE-SERVICE] Field %s tidak ditemukan: %v\n", fieldID, err)
+			return nil, err
+		}
+		fieldIDs = append(fieldIDs, field.UUID.String())
+	}
+
+	// 💾 Step 4: Simpan closure dan blokir slot dalam satu transaksi
+	// 📝 Catatan:
+	// Slot tidak dihapus. Status yang boleh pindah ke Blocked (sesuai state machine) diblokir,
+	// sedangkan slot Booked tetap Booked tapi ditandai needs_follow_up.
+	// Kalau blokir gagal, closure ikut di-rollback supaya tidak ada closure tanpa slot yang terblokir.
+	fromStatuses := make([]constants.FieldScheduleStatus, 0)
+	for _, status := range constants.FieldScheduleStatusesAllowedTo(constants.Blocked) {
+		if status != constants.Booked {
+			fromStatuses = append(fromStatuses, status)
+		}
+	}
+
+	var (
+		closure          *models.Closure
+		blocked, flagged int64
+	)
+	err = c.repository.WithTransaction(ctx, func(repository repositories.IRepositoryRegistry) error {
+		closure, err = repository.GetClosure().Create(ctx, &models.Closure{
+			Reason:    request.Reason,
+			StartDate: startDate,
+			EndDate:   endDate,
+			FieldIDs:  fieldIDs,
+		})
+		if err != nil {
+			fmt.Println("❌ [ERROR-CLOSURE-SERVICE] Gagal menyimpan closure:", err)
+			return err
+		}
+
+		// 🔒 Step 5: Blokir slot yang sudah ter-generate di rentang closure
+		blocked, flagged, err = repository.GetFieldSchedule().BlockByClosure(ctx, closure, fromStatuses)
+		if err != nil {
+			fmt.Println("❌ [ERROR-CLOSURE-SERVICE] Gagal memblokir schedule:", err)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := c.toResponse(closure)
+	response.BlockedSchedules = blocked
+	response.FlaggedBookings = flagged
+	fmt.Printf("🏁 [INFO-CLOSURE-SERVICE] Create selesai: %+v\n", response)
+	return &response, nil
+}
+
+func (c *ClosureService) Delete(ctx context.Context, uuid string) error {
+	fmt.Println("🚀 [DEBUG-CLOSURE-SERVICE] Delete:", uuid)
+
+	// 1️⃣ Cek closure ada atau tidak
+	closure, err := c.repository.GetClosure().FindByUUID(ctx, uuid)
+	if err != nil {
+		fmt.Println("❌ [ERROR-CLOSURE-SERVICE] Gagal mengambil data closure:", err)
+		return err
+	}
+
+	// 2️⃣ Buka kembali slot yang diblokir oleh closure ini lalu hapus closure dalam satu transaksi
+	// 📝 Catatan:
+	// Slot yang masih tercakup closure lain tetap Blocked dan dipindah ke closure tersebut.
+	err = c.repository.WithTransaction(ctx, func(repository repositories.IRepositoryRegistry) error {
+		unblocked, err := repository.GetFieldSchedule().UnblockByClosure(ctx, closure.ID)
+		if err != nil {
+			fmt.Println("❌ [ERROR-CLOSURE-SERVICE] Gagal unblock schedule:", err)
+			return err
+		}
+		fmt.Println("✅ [INFO-CLOSURE-SERVICE] Schedule dibuka kembali:", unblocked)
+
+		// 3️⃣ Hapus closure
+		err = repository.GetClosure().Delete(ctx, uuid)
+		if err != nil {
+			fmt.Println("❌ [ERROR-CLOSURE-SERVICE] Gagal menghapus closure:", err)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("🏁 [INFO-CLOSURE-SERVICE] Delete selesai")
+	return nil
+}
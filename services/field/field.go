@@ -6,6 +6,7 @@ import (
 	"field-service/common/gcs"
 	"field-service/common/util"
 	errConstant "field-service/constants/error"
+	errField "field-service/constants/error/field"
 	"field-service/domain/dto"
 	"field-service/domain/models"
 	"field-service/repositories"
@@ -16,6 +17,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 type FieldService struct {
@@ -25,11 +27,13 @@ type FieldService struct {
 
 type IFieldService interface {
 	GetAllWithPagination(context.Context, *dto.FieldRequestParam) (*util.PaginationResult, error)
-	GetAllWithoutPagination(context.Context) ([]dto.FieldResponse, error)
+	GetAllWithoutPagination(context.Context, *dto.FieldFilterParam) ([]dto.FieldResponse, error)
 	GetByUUID(context.Context, string) (*dto.FieldResponse, error)
 	Create(context.Context, *dto.FieldRequest) (*dto.FieldResponse, error)
 	Update(context.Context, string, *dto.UpdateFieldRequest) (*dto.FieldResponse, error)
 	Delete(context.Context, string) error
+	GetAllDeleted(context.Context) ([]dto.FieldResponse, error)
+	Restore(context.Context, string) (*dto.FieldResponse, error)
 }
 
 func NewFieldService(repository repositories.IRepositoryRegistry, gcs gcs.IGCSClient) IFieldService {
@@ -56,6 +60,12 @@ func (f *FieldService) GetAllWithPagination(
 			Name:         field.Name,
 			PricePerHour: field.PricePerHour,
 			Images:       field.Images,
+			SportType:    field.SportType,
+			Surface:      field.Surface,
+			IsIndoor:     field.IsIndoor,
+			Capacity:     field.Capacity,
+			Amenities:    field.Amenities,
+			Venue:        f.venueResponse(field.Venue),
 			CreatedAt:    field.CreatedAt,
 			UpdatedAt:    field.UpdatedAt,
 		})
@@ -75,8 +85,11 @@ func (f *FieldService) GetAllWithPagination(
 	return &response, nil
 }
 
-func (f *FieldService) GetAllWithoutPagination(ctx context.Context) ([]dto.FieldResponse, error) {
-	fields, err := f.repository.GetField().FindAllWithoutPagination(ctx)
+func (f *FieldService) GetAllWithoutPagination(
+	ctx context.Context,
+	filter *dto.FieldFilterParam,
+) ([]dto.FieldResponse, error) {
+	fields, err := f.repository.GetField().FindAllWithoutPagination(ctx, filter)
 	if err != nil {
 		fmt.Println("🔍 [DEBUG-FIELD-SERVICE] GetAllWithoutPagination", err)
 		return nil, err
@@ -89,6 +102,12 @@ func (f *FieldService) GetAllWithoutPagination(ctx context.Context) ([]dto.Field
 			Name:         field.Name,
 			PricePerHour: field.PricePerHour,
 			Images:       field.Images,
+			SportType:    field.SportType,
+			Surface:      field.Surface,
+			IsIndoor:     field.IsIndoor,
+			Capacity:     field.Capacity,
+			Amenities:    field.Amenities,
+			Venue:        f.venueResponse(field.Venue),
 		})
 	}
 	fmt.Println("🔍 [DEBUG-FIELD-SERVICE] GetAllWithoutPagination", fieldResults)
@@ -108,6 +127,12 @@ func (f *FieldService) GetByUUID(ctx context.Context, uuid string) (*dto.FieldRe
 		Name:         fields.Name,
 		PricePerHour: fields.PricePerHour,
 		Images:       fields.Images,
+		SportType:    fields.SportType,
+		Surface:      fields.Surface,
+		IsIndoor:     fields.IsIndoor,
+		Capacity:     fields.Capacity,
+		Amenities:    fields.Amenities,
+		Venue:        f.venueResponse(fields.Venue),
 		CreatedAt:    fields.CreatedAt,
 		UpdatedAt:    fields.UpdatedAt,
 	}
@@ -116,6 +141,39 @@ func (f *FieldService) GetByUUID(ctx context.Context, uuid string) (*dto.FieldRe
 	return &fieldResult, nil
 }
 
+// findVenue mengambil venue berdasarkan UUID, venueID kosong artinya field tanpa venue.
+func (f *FieldService) findVenue(ctx context.Context, venueID string) (*models.Venue, error) {
+	if venueID == "" {
+		return nil, nil
+	}
+	return f.repository.GetVenue().FindByUUID(ctx, venueID)
+}
+
+func (f *FieldService) venueID(venue *models.Venue) *uint {
+	if venue == nil {
+		return nil
+	}
+	return &venue.ID
+}
+
+func (f *FieldService) venueResponse(venue *models.Venue) *dto.FieldVenueResponse {
+	if venue == nil {
+		return nil
+	}
+	return &dto.FieldVenueResponse{
+		UUID: venue.UUID,
+		Name: venue.Name,
+	}
+}
+
+// amenities memastikan list amenities tidak nil, karena kolomnya NOT NULL (array kosong = tidak ada amenity).
+func (f *FieldService) amenities(values []string) pq.StringArray {
+	if values == nil {
+		return pq.StringArray{}
+	}
+	return values
+}
+
 func (f *FieldService) validateUpload(images []multipart.FileHeader) error {
 	if len(images) == 0 {
 		return errConstant.ErrInvalidUploadFile
@@ -185,6 +243,12 @@ func (f *FieldService) Create(ctx context.Context, request *dto.FieldRequest) (*
 	fmt.Printf("🔍 [DEBUG-FIELD-SERVICE] Incoming request: %+v\n", request.Name)
 	fmt.Printf("🔍 [DEBUG-FIELD-SERVICE] Incoming request: %+v\n", request.PricePerHour)
 
+	venue, err := f.findVenue(ctx, request.VenueID)
+	if err != nil {
+		fmt.Println("🔍 [DEBUG-FIELD-SERVICE] Create", err)
+		return nil, err
+	}
+
 	// 🔍 Debug khusus field Images
 	fmt.Printf("🔍 [DEBUG-FIELD-SERVICE] Images data: %+v\n", len(request.Images))
 	imageUrl, err := f.uploadImage(ctx, request.Images)
@@ -194,10 +258,16 @@ func (f *FieldService) Create(ctx context.Context, request *dto.FieldRequest) (*
 	}
 
 	field, err := f.repository.GetField().Create(ctx, &models.Field{
+		VenueID:      f.venueID(venue),
 		Code:         request.Code,
 		Name:         request.Name,
 		PricePerHour: request.PricePerHour,
 		Images:       imageUrl,
+		SportType:    request.SportType,
+		Surface:      request.Surface,
+		IsIndoor:     request.IsIndoor,
+		Capacity:     request.Capacity,
+		Amenities:    f.amenities(request.Amenities),
 	})
 	if err != nil {
 		return nil, err
@@ -209,6 +279,12 @@ func (f *FieldService) Create(ctx context.Context, request *dto.FieldRequest) (*
 		Name:         field.Name,
 		PricePerHour: field.PricePerHour,
 		Images:       imageUrl,
+		SportType:    field.SportType,
+		Surface:      field.Surface,
+		IsIndoor:     field.IsIndoor,
+		Capacity:     field.Capacity,
+		Amenities:    field.Amenities,
+		Venue:        f.venueResponse(venue),
 		CreatedAt:    field.CreatedAt,
 		UpdatedAt:    field.UpdatedAt,
 	}
@@ -224,6 +300,17 @@ func (f *FieldService) Update(ctx context.Context, uuidParam string, req *dto.Up
 		return nil, err
 	}
 
+	// 📝 Catatan:
+	// venueID kosong artinya venue tidak diubah.
+	venue := field.Venue
+	if req.VenueID != "" {
+		venue, err = f.findVenue(ctx, req.VenueID)
+		if err != nil {
+			fmt.Println("🔍 [DEBUG-FIELD-SERVICE] Update", err)
+			return nil, err
+		}
+	}
+
 	var imageUrl []string
 	if req.Images == nil {
 		imageUrl = field.Images
@@ -236,10 +323,16 @@ func (f *FieldService) Update(ctx context.Context, uuidParam string, req *dto.Up
 	}
 
 	fieldResult, err := f.repository.GetField().Update(ctx, uuidParam, &models.Field{
+		VenueID:      f.venueID(venue),
 		Code:         req.Code,
 		Name:         req.Name,
 		PricePerHour: req.PricePerHour,
 		Images:       imageUrl,
+		SportType:    req.SportType,
+		Surface:      req.Surface,
+		IsIndoor:     req.IsIndoor,
+		Capacity:     req.Capacity,
+		Amenities:    f.amenities(req.Amenities),
 	})
 	if err != nil {
 		return nil, err
@@ -252,6 +345,12 @@ func (f *FieldService) Update(ctx context.Context, uuidParam string, req *dto.Up
 		Name:         fieldResult.Name,
 		PricePerHour: fieldResult.PricePerHour,
 		Images:       fieldResult.Images,
+		SportType:    fieldResult.SportType,
+		Surface:      fieldResult.Surface,
+		IsIndoor:     fieldResult.IsIndoor,
+		Capacity:     fieldResult.Capacity,
+		Amenities:    fieldResult.Amenities,
+		Venue:        f.venueResponse(venue),
 		CreatedAt:    fieldResult.CreatedAt,
 		UpdatedAt:    fieldResult.UpdatedAt,
 	}, nil
@@ -259,17 +358,101 @@ func (f *FieldService) Update(ctx context.Context, uuidParam string, req *dto.Up
 
 func (f *FieldService) Delete(ctx context.Context, uuid string) error {
 	//cek dulu datanya ada atau tidak
-	_, err := f.repository.GetField().FindByUUID(ctx, uuid)
+	field, err := f.repository.GetField().FindByUUID(ctx, uuid)
 	if err != nil {
 		return err
 	}
 
-	err = f.repository.GetField().Delete(ctx, uuid)
+	// 📝 Catatan:
+	// Field yang masih punya booking mendatang tidak boleh dihapus. "Hari ini" mengikuti timezone venue.
+	timezone := ""
+	if field.Venue != nil {
+		timezone = field.Venue.Timezone
+	}
+	today := util.DateIn(time.Now(), util.LoadLocation(timezone)).Format(time.DateOnly)
+
+	// 📝 Catatan:
+	// Jadwal mendatang dikunci dulu, lalu cek booking dan soft delete dijalankan di transaksi yang sama,
+	// jadi booking yang masuk bersamaan tidak bisa lolos di antara cek dan delete.
+	err = f.repository.WithTransaction(ctx, func(repository repositories.IRepositoryRegistry) error {
+		err := repository.GetFieldSchedule().LockFromDateByFieldID(ctx, field.ID, today)
+		if err != nil {
+			fmt.Println("🔍 [DEBUG-FIELD-SERVICE] Delete", err)
+			return err
+		}
+
+		booked, err := repository.GetFieldSchedule().CountBookedFromDateByFieldID(ctx, field.ID, today)
+		if err != nil {
+			fmt.Println("🔍 [DEBUG-FIELD-SERVICE] Delete", err)
+			return err
+		}
+		if booked > 0 {
+			fmt.Println("🔍 [DEBUG-FIELD-SERVICE] Delete ditolak, booking mendatang:", booked)
+			return errField.ErrFieldHasFutureBookings
+		}
+
+		// Soft delete field + jadwal mulai hari ini, jadwal lama tetap jadi riwayat
+		err = repository.GetField().Delete(ctx, uuid, today)
+		if err != nil {
+			fmt.Println("🔍 [DEBUG-FIELD-SERVICE] Delete", err)
+			return err
+		}
+		return nil
+	})
 	if err != nil {
-		fmt.Println("🔍 [DEBUG-FIELD-SERVICE] Delete", err)
 		return err
 	}
 
 	fmt.Println("🔍 [DEBUG-FIELD-SERVICE] Delete", "success")
 	return nil
 }
+
+func (f *FieldService) GetAllDeleted(ctx context.Context) ([]dto.FieldResponse, error) {
+	fields, err := f.repository.GetField().FindAllDeleted(ctx)
+	if err != nil {
+		fmt.Println("🔍 [DEBUG-FIELD-SERVICE] GetAllDeleted", err)
+		return nil, err
+	}
+
+	fieldResults := make([]dto.FieldResponse, 0, len(fields))
+	for _, field := range fields {
+		fieldResults = append(fieldResults, f.fieldResponse(&field))
+	}
+	fmt.Println("🔍 [DEBUG-FIELD-SERVICE] GetAllDeleted", len(fieldResults))
+	return fieldResults, nil
+}
+
+func (f *FieldService) Restore(ctx context.Context, uuid string) (*dto.FieldResponse, error) {
+	field, err := f.repository.GetField().Restore(ctx, uuid)
+	if err != nil {
+		fmt.Println("🔍 [DEBUG-FIELD-SERVICE] Restore", err)
+		return nil, err
+	}
+
+	response := f.fieldResponse(field)
+	fmt.Println("🔍 [DEBUG-FIELD-SERVICE] Restore", "success")
+	return &response, nil
+}
+
+// fieldResponse dipakai listing trash dan restore, DeletedAt ikut diisi kalau field masih terhapus.
+func (f *FieldService) fieldResponse(field *models.Field) dto.FieldResponse {
+	response := dto.FieldResponse{
+		UUID:         field.UUID,
+		Code:         field.Code,
+		Name:         field.Name,
+		PricePerHour: field.PricePerHour,
+		Images:       field.Images,
+		SportType:    field.SportType,
+		Surface:      field.Surface,
+		IsIndoor:     field.IsIndoor,
+		Capacity:     field.Capacity,
+		Amenities:    field.Amenities,
+		Venue:        f.venueResponse(field.Venue),
+		CreatedAt:    field.CreatedAt,
+		UpdatedAt:    field.UpdatedAt,
+	}
+	if field.DeletedAt.Valid {
+		response.DeletedAt = &field.DeletedAt.Time
+	}
+	return response
+}
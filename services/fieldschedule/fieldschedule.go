@@ -3,12 +3,17 @@ package services
 import (
 	"context"
 	"field-service/common/util"
+	"field-service/config"
 	"field-service/constants"
 	errFieldSchedule "field-service/constants/error/fieldschedule"
+	errTime "field-service/constants/error/time"
 	"field-service/domain/dto"
 	"field-service/domain/models"
 	"field-service/repositories"
+	fieldScheduleRepositories "field-service/repositories/fieldschedule"
+	pricingService "field-service/services/pricing"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -20,15 +25,36 @@ type FieldScheduleService struct {
 
 type IFieldScheduleService interface {
 	GetAllWithPagination(context.Context, *dto.FieldScheduleRequestParam) (*util.PaginationResult, error)
+	GetAllWithCursor(context.Context, *dto.FieldScheduleCursorRequestParam) (*util.CursorPaginationResult, error)
 	GetAllByFieldIDAndDate(context.Context, string, string) ([]dto.FieldScheduleForBookingResponse, error)
 	GetByUUID(context.Context, string) (*dto.FieldScheduleResponse, error)
-	GenerateScheduleForOneMonth(context.Context, *dto.GenerateFieldScheduleForOneMonthRequest) error
-	Create(context.Context, *dto.FieldScheduleRequest) error
+	SearchAvailability(context.Context, *dto.FieldAvailabilityRequestParam) ([]dto.FieldAvailabilityResponse, error)
+	GenerateScheduleForOneMonth(context.Context, *dto.GenerateFieldScheduleForOneMonthRequest) (*dto.GenerateFieldScheduleResponse, error)
+	Generate(context.Context, *dto.GenerateFieldScheduleRequest) (*dto.GenerateFieldScheduleResponse, error)
+	GenerateRolling(context.Context, int) (*dto.GenerateFieldScheduleResponse, error)
+	Create(context.Context, *dto.FieldScheduleRequest) (*dto.GenerateFieldScheduleResponse, error)
 	Update(context.Context, string, *dto.UpdateFieldScheduleRequest) (*dto.FieldScheduleResponse, error)
 	UpdateStatus(context.Context, *dto.UpdateStatusFieldScheduleRequest) error
-	Delete(context.Context, string) error
+	BookRange(context.Context, *dto.BookRangeFieldScheduleRequest) (*dto.BookRangeFieldScheduleResponse, error)
+	Hold(context.Context, *dto.HoldFieldScheduleRequest) (*dto.HoldFieldScheduleResponse, error)
+	ReleaseHold(context.Context, *dto.ReleaseHoldFieldScheduleRequest) error
+	ReleaseExpiredHolds(context.Context) (int64, error)
+	Release(context.Context, *dto.ReleaseFieldScheduleRequest) error
+	ChangeStatus(context.Context, *dto.ChangeStatusFieldScheduleRequest) error
+	Delete(context.Context, string, *dto.DeleteFieldScheduleRequest) error
+	GetEvents(context.Context, *dto.FieldScheduleEventRequestParam) ([]dto.FieldScheduleEventResponse, error)
+	GetAllDeletedWithPagination(context.Context, *dto.FieldScheduleRequestParam) (*util.PaginationResult, error)
+	Restore(context.Context, string) (*dto.FieldScheduleResponse, error)
 }
 
+const (
+	defaultHoldDurationMinutes = 10
+	// maxGenerateDays membatasi rentang tanggal untuk satu kali generate schedule
+	maxGenerateDays = 366
+	// defaultEventLimit adalah jumlah event per halaman feed kalau limit tidak dikirim
+	defaultEventLimit = 100
+)
+
 func NewFieldScheduleService(repository repositories.IRepositoryRegistry) IFieldScheduleService {
 	return &FieldScheduleService{repository: repository}
 }
@@ -53,30 +79,16 @@ func (f *FieldScheduleService) GetAllWithPagination(
 	// Ambil data schedule + total datanya berapa semua.
 	// Kalau error ambil datanya, hentikan proses.
 
-	// 2️⃣ Siapkan tempat kosong (slice) buat tampung hasil response per item
-	fieldSchedulesResults := make([]dto.FieldScheduleResponse, 0, len(fieldSchedules))
-	// 📝 Catatan:
-	// Kita siapkan "wadah kosong" untuk hasil akhir (data response).
-
-	// 3️⃣ Loop tiap data schedule → ubah jadi bentuk response
-	for _, schedule := range fieldSchedules {
-		fieldSchedulesResults = append(fieldSchedulesResults, dto.FieldScheduleResponse{
-			UUID:         schedule.UUID,
-			FieldName:    schedule.Field.Name,
-			Date:         schedule.Date.Format("2006-01-02"),
-			PricePerHour: schedule.Field.PricePerHour,
-			Status:       schedule.Status.GetStatusString(),
-			Time:         fmt.Sprintf("%s - %s", schedule.Time.StartTime, schedule.Time.EndTime),
-			CreatedAt:    schedule.CreatedAt,
-			UpdatedAt:    schedule.UpdatedAt,
-		})
-		fmt.Printf("🔄 [DEBUG-FIELD-SCHEDULE-SERVICE] Proses schedule: %+v\n", schedule)
+	// 2️⃣ Ubah tiap data schedule jadi bentuk response
+	fieldSchedulesResults, err := f.scheduleResponses(ctx, fieldSchedules)
+	if err != nil {
+		return nil, err
 	}
 	fmt.Printf("✅ [INFO-FIELD-SCHEDULE-SERVICE] Hasil response per schedule siap: %+v\n", fieldSchedulesResults)
 	// 📝 Catatan:
 	// Setiap data mentah kita ubah jadi response yang sudah rapi (format tanggal, status, harga, dll).
 
-	// 4️⃣ Siapkan data pagination param (isi total count, limit, page + datanya)
+	// 3️⃣ Siapkan data pagination param (isi total count, limit, page + datanya)
 	pagination := &util.PaginationParam{
 		Count: total,
 		Limit: param.Limit,
@@ -87,14 +99,134 @@ func (f *FieldScheduleService) GetAllWithPagination(
 	// 📝 Catatan:
 	// Bungkus semua hasil + info pagination jadi 1 objek PaginationParam.
 
-	// 5️⃣ Generate response pagination
+	// 4️⃣ Generate response pagination
 	response := util.GeneratePagination(*pagination)
 	fmt.Printf("✅ [INFO-FIELD-SCHEDULE-SERVICE] Response Pagination siap: %+v\n", response)
 
-	// 6️⃣ Return hasil response pagination
+	// 5️⃣ Return hasil response pagination
+	return &response, nil
+}
+
+// GetAllWithCursor mengambil list jadwal dengan keyset pagination. Cocok untuk dashboard admin
+// karena tidak memakai OFFSET dan COUNT(*) hanya dijalankan kalau diminta (withTotal=true).
+func (f *FieldScheduleService) GetAllWithCursor(
+	ctx context.Context,
+	param *dto.FieldScheduleCursorRequestParam,
+) (*util.CursorPaginationResult, error) {
+	fmt.Println("🚀 [DEBUG-FIELD-SCHEDULE-SERVICE] Start GetAllWithCursor")
+	fmt.Printf("📥 [DEBUG-FIELD-SCHEDULE-SERVICE] Param request: %+v\n", param)
+
+	// 1️⃣ Ambil limit+1 baris setelah cursor
+	fieldSchedules, total, err := f.repository.GetFieldSchedule().FindAllWithCursor(ctx, param)
+	if err != nil {
+		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal ambil data fieldSchedule:", err)
+		return nil, err
+	}
+
+	// 2️⃣ Kalau dapat lebih dari limit berarti masih ada halaman berikutnya,
+	// cursor berikutnya diambil dari baris terakhir yang dikembalikan
+	var nextCursor *string
+	if len(fieldSchedules) > param.Limit {
+		fieldSchedules = fieldSchedules[:param.Limit]
+		last := fieldSchedules[len(fieldSchedules)-1]
+		cursor := util.EncodeCursor(last.CreatedAt, last.ID)
+		nextCursor = &cursor
+	}
+
+	// 3️⃣ Ubah jadi bentuk response
+	fieldSchedulesResults, err := f.scheduleResponses(ctx, fieldSchedules)
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Printf("✅ [INFO-FIELD-SCHEDULE-SERVICE] GetAllWithCursor selesai: %d data\n", len(fieldSchedulesResults))
+	return &util.CursorPaginationResult{
+		NextCursor: nextCursor,
+		Limit:      param.Limit,
+		TotalData:  total,
+		Data:       fieldSchedulesResults,
+	}, nil
+}
+
+// scheduleResponses mengubah jadwal jadi response list.
+// Harga pakai snapshot saat booking kalau ada, kalau belum dibooking pakai harga efektif saat ini.
+func (f *FieldScheduleService) scheduleResponses(
+	ctx context.Context,
+	fieldSchedules []models.FieldSchedule,
+) ([]dto.FieldScheduleResponse, error) {
+	fieldSchedulesResults := make([]dto.FieldScheduleResponse, 0, len(fieldSchedules))
+	priceOf := f.schedulePricer(ctx)
+	for _, schedule := range fieldSchedules {
+		pricePerHour, err := priceOf(schedule)
+		if err != nil {
+			fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal hitung harga:", err)
+			return nil, err
+		}
+
+		loc := f.fieldLocation(&schedule.Field)
+		fieldSchedulesResults = append(fieldSchedulesResults, dto.FieldScheduleResponse{
+			UUID:          schedule.UUID,
+			FieldName:     schedule.Field.Name,
+			Date:          schedule.Date.Format(time.DateOnly),
+			PricePerHour:  pricePerHour,
+			Status:        schedule.Status.GetStatusString(),
+			Time:          fmt.Sprintf("%s - %s", schedule.Time.StartTime, schedule.Time.EndTime),
+			NeedsFollowUp: schedule.NeedsFollowUp,
+			Timezone:      loc.String(),
+			CreatedAt:     f.inLocation(schedule.CreatedAt, loc),
+			UpdatedAt:     f.inLocation(schedule.UpdatedAt, loc),
+		})
+		if schedule.DeletedAt.Valid {
+			fieldSchedulesResults[len(fieldSchedulesResults)-1].DeletedAt = f.inLocation(&schedule.DeletedAt.Time, loc)
+		}
+	}
+	return fieldSchedulesResults, nil
+}
+
+// GetAllDeletedWithPagination mengambil jadwal yang sudah dihapus (trash) untuk admin.
+func (f *FieldScheduleService) GetAllDeletedWithPagination(
+	ctx context.Context,
+	param *dto.FieldScheduleRequestParam,
+) (*util.PaginationResult, error) {
+	fmt.Println("🚀 [DEBUG-FIELD-SCHEDULE-SERVICE] Start GetAllDeletedWithPagination")
+	fieldSchedules, total, err := f.repository.GetFieldSchedule().FindAllDeletedWithPagination(ctx, param)
+	if err != nil {
+		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal ambil data fieldSchedule terhapus:", err)
+		return nil, err
+	}
+
+	fieldSchedulesResults, err := f.scheduleResponses(ctx, fieldSchedules)
+	if err != nil {
+		return nil, err
+	}
+
+	response := util.GeneratePagination(util.PaginationParam{
+		Count: total,
+		Limit: param.Limit,
+		Page:  param.Page,
+		Data:  fieldSchedulesResults,
+	})
 	return &response, nil
 }
 
+// Restore mengembalikan jadwal yang sudah dihapus.
+func (f *FieldScheduleService) Restore(ctx context.Context, uuid string) (*dto.FieldScheduleResponse, error) {
+	fmt.Println("🚀 [DEBUG-FIELD-SCHEDULE-SERVICE] Start Restore:", uuid)
+	fieldSchedule, err := f.repository.GetFieldSchedule().Restore(ctx, uuid)
+	if err != nil {
+		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal restore fieldSchedule:", err)
+		return nil, err
+	}
+
+	results, err := f.scheduleResponses(ctx, []models.FieldSchedule{*fieldSchedule})
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Printf("✅ [INFO-FIELD-SCHEDULE-SERVICE] FieldSchedule berhasil di-restore: %s\n", uuid)
+	return &results[0], nil
+}
+
 func (f *FieldScheduleService) convertMontName(inputDate string) string {
 	date, err := time.Parse(time.DateOnly, inputDate)
 	if err != nil {
@@ -143,6 +275,13 @@ func (f *FieldScheduleService) GetAllByFieldIDAndDate(
 	// Kita pastikan lapangan dengan UUID yang dikirim user itu memang ada di database.
 	// Kalau tidak ada (error), hentikan proses.
 
+	// 🕒 Tanggal kosong artinya "hari ini" menurut timezone venue, bukan timezone server
+	loc := f.fieldLocation(field)
+	if date == "" {
+		date = util.DateIn(time.Now(), loc).Format(time.DateOnly)
+		fmt.Println("🕒 [DEBUG-SERVICE] Date default (hari ini di", loc.String(), "):", date)
+	}
+
 	// 2️⃣ Ambil semua jadwal (schedules) untuk field ID dan tanggal yang diminta
 	fieldSchedules, err := f.repository.GetFieldSchedule().FindAllByFieldIDAndDate(ctx, int(field.ID), date)
 	if err != nil {
@@ -154,6 +293,9 @@ func (f *FieldScheduleService) GetAllByFieldIDAndDate(
 	// Kita ambil semua jadwal yang sesuai lapangan + tanggal.
 	// Kalau gagal ambil (error), hentikan proses.
 
+	// 💰 Harga per slot: snapshot booking kalau ada, kalau tidak harga efektif dari pricing rule
+	priceOf := f.schedulePricer(ctx)
+
 	// 3️⃣ Siapkan tempat (slice) untuk tampung hasil response
 	fieldScheduleResult := make([]dto.FieldScheduleForBookingResponse, 0, len(fieldSchedules))
 	// 📝 Catatan:
@@ -161,7 +303,12 @@ func (f *FieldScheduleService) GetAllByFieldIDAndDate(
 
 	// 4️⃣ Looping setiap schedule → proses dan isi respons
 	for _, schedule := range fieldSchedules {
-		pricePerHour := float64(schedule.Field.PricePerHour)
+		price, err := priceOf(schedule)
+		if err != nil {
+			fmt.Println("❌ [ERROR-SERVICE] Gagal hitung harga:", err)
+			return nil, err
+		}
+		pricePerHour := float64(price)
 
 		fmt.Println("🔍 [DEBUG-SERVICE] Processing schedule:", schedule)
 
@@ -171,10 +318,12 @@ func (f *FieldScheduleService) GetAllByFieldIDAndDate(
 			Time:         schedule.Time.StartTime,
 			Status:       schedule.Status.GetStatusString(),
 			PricePerHour: util.RupiahFormat(&pricePerHour),
+			Timezone:     loc.String(),
 		})
 	}
 	// 📝 Catatan:
-	// Untuk setiap jadwal yang ketemu, kita ubah ke bentuk response yang lebih rapi + format harga rupiah + format tanggal + status string.
+	// Untuk setiap jadwal yang ketemu, kita ubah ke bentuk response yang lebih rapi + harga efektif (hasil pricing rule)
+	// dalam format rupiah + format tanggal + status string.
 
 	// 5️⃣ Return hasil akhirnya
 	fmt.Println("✅ [INFO-SERVICE] FieldScheduleResult:", fieldScheduleResult)
@@ -192,309 +341,1316 @@ func (f *FieldScheduleService) GetByUUID(ctx context.Context, uuid string) (*dto
 	}
 	fmt.Println("✅ [INFO-FIELD-SCHEDULE-SERVICE] FieldSchedule ditemukan:", fieldSchedule)
 
+	// 2️⃣ Harga: snapshot saat booking kalau ada, kalau tidak harga efektif dari pricing rule
+	pricePerHour, err := f.schedulePricer(ctx)(*fieldSchedule)
+	if err != nil {
+		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal hitung harga", err)
+		return nil, err
+	}
+
+	loc := f.fieldLocation(&fieldSchedule.Field)
 	response := dto.FieldScheduleResponse{
-		UUID:         fieldSchedule.UUID,
-		FieldName:    fieldSchedule.Field.Name,
-		PricePerHour: fieldSchedule.Field.PricePerHour,
-		Date:         fieldSchedule.Date.Format(time.DateOnly),
-		Status:       fieldSchedule.Status.GetStatusString(),
-		Time:         fmt.Sprintf("%s - %s", fieldSchedule.Time.StartTime, fieldSchedule.Time.EndTime),
-		CreatedAt:    fieldSchedule.CreatedAt,
-		UpdatedAt:    fieldSchedule.UpdatedAt,
+		UUID:          fieldSchedule.UUID,
+		FieldName:     fieldSchedule.Field.Name,
+		PricePerHour:  pricePerHour,
+		Date:          fieldSchedule.Date.Format(time.DateOnly),
+		Status:        fieldSchedule.Status.GetStatusString(),
+		Time:          fmt.Sprintf("%s - %s", fieldSchedule.Time.StartTime, fieldSchedule.Time.EndTime),
+		NeedsFollowUp: fieldSchedule.NeedsFollowUp,
+		Timezone:      loc.String(),
+		CreatedAt:     f.inLocation(fieldSchedule.CreatedAt, loc),
+		UpdatedAt:     f.inLocation(fieldSchedule.UpdatedAt, loc),
 	}
 
 	fmt.Println("✅ [INFO-FIELD-SCHEDULE-SERVICE] Response yang dikembalikan:", response)
 	return &response, nil
 }
 
-func (f *FieldScheduleService) GenerateScheduleForOneMonth(ctx context.Context, request *dto.GenerateFieldScheduleForOneMonthRequest) error {
-	// 🚀 Start debug
-	fmt.Println("🚀 [DEBUG-FIELD-SCHEDULE-SERVICE] GenerateScheduleForOneMonth - Start")
-	fmt.Printf("📥 [DEBUG-FIELD-SCHEDULE-SERVICE] Input request: %+v\n", request)
+// SearchAvailability mencari field yang punya slot Available di tanggal dan rentang jam yang diminta.
+// Query-nya tetap sedikit: satu query slot (join field, venue, time) dan satu query pricing rule
+// untuk semua field yang ketemu, tidak peduli berapa banyak field-nya.
+func (f *FieldScheduleService) SearchAvailability(
+	ctx context.Context,
+	param *dto.FieldAvailabilityRequestParam,
+) ([]dto.FieldAvailabilityResponse, error) {
+	fmt.Println("🚀 [DEBUG-FIELD-SCHEDULE-SERVICE] Start SearchAvailability")
+	fmt.Printf("📥 [DEBUG-FIELD-SCHEDULE-SERVICE] Param request: %+v\n", param)
 
-	// ✅ Step 1: Cek field (lapangan) ada atau tidak
-	field, err := f.repository.GetField().FindByUUID(ctx, request.FieldID)
+	// 1️⃣ Validasi tanggal dan rentang jam
+	date, err := time.Parse(time.DateOnly, param.Date)
 	if err != nil {
-		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal ambil field:", err)
-		return err
+		return nil, errFieldSchedule.ErrInvalidDateRange
 	}
-	fmt.Printf("✅ [INFO-FIELD-SCHEDULE-SERVICE] Field ditemukan: %+v\n", field)
 
-	// ✅ Step 2: Ambil semua time slotnya (jam nya)
-	times, err := f.repository.GetTime().FindAll(ctx)
+	startTime, err := f.parseClock(param.StartTime)
 	if err != nil {
-		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal ambil time:", err)
-		return err
+		return nil, errFieldSchedule.ErrInvalidTimeRange
 	}
-	fmt.Printf("✅ [INFO-FIELD-SCHEDULE-SERVICE] Time ditemukan: %+v\n", len(times))
-
-	// ✅ Step 3: Tentukan jumlah hari (30 hari dari hari besok)
-	numberOfDays := 30
-	Now := time.Now().Add(time.Duration(1) * 24 * time.Hour) // mulai dari besok
-	fmt.Printf("📆 [DEBUG-FIELD-SCHEDULE-SERVICE] Generate schedule mulai dari besok: %s untuk %d hari\n", Now.Format("2006-01-02"), numberOfDays)
-
-	// ✅ Step 4: Buat wadah kosong untuk menampung daftar jadwal baru
-	fieldSchedules := make([]models.FieldSchedule, 0, numberOfDays)
-	fmt.Println("📦 [DEBUG-FIELD-SCHEDULE-SERVICE] Wadah kosong untuk jadwal sudah disiapkan")
 
-	// 🔄 Step 5: Loop untuk semua tanggal (besok sampai 30 hari kedepan)
-	for i := 0; i < numberOfDays; i++ {
-		currentDate := Now.AddDate(0, 0, i)
-		fmt.Printf("🔄 [DEBUG-FIELD-SCHEDULE-SERVICE] Tanggal yang diproses: %s\n", currentDate.Format("2006-01-02"))
+	endTime, err := f.parseClock(param.EndTime)
+	if err != nil || endTime <= startTime {
+		return nil, errFieldSchedule.ErrInvalidTimeRange
+	}
 
-		// 🔄 Step 6: Loop untuk semua time slot di setiap tanggal
-		for _, item := range times {
-			fmt.Printf("🔄 [DEBUG-FIELD-SCHEDULE-SERVICE] Proses TimeSlot: %s (TimeID: %d)\n", item.StartTime, item.ID)
+	if param.MinPrice != nil && param.MaxPrice != nil && *param.MinPrice > *param.MaxPrice {
+		fmt.Println("⚠️ [WARN-FIELD-SCHEDULE-SERVICE] MinPrice lebih besar dari MaxPrice")
+		return []dto.FieldAvailabilityResponse{}, nil
+	}
 
-			// 7️⃣ Step 7: Cek apakah jadwal sudah ada (hindari duplikat)
-			shcedule, err := f.repository.GetFieldSchedule().FindByDateAndTimeID(
-				ctx, currentDate.Format(time.DateOnly),
-				int(item.ID), int(field.ID),
-			)
-			if err != nil {
-				fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal ambil schedule:", err)
-				return err
-			}
+	// 2️⃣ Ambil semua slot available di rentang tersebut (sudah urut per field + jam)
+	filter := *param
+	filter.StartTime = startTime
+	filter.EndTime = endTime
+	fieldSchedules, err := f.repository.GetFieldSchedule().FindAvailable(ctx, &filter)
+	if err != nil {
+		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal cari slot available:", err)
+		return nil, err
+	}
 
-			if shcedule != nil {
-				fmt.Println("⚠️ [WARN-FIELD-SCHEDULE-SERVICE] Schedule sudah ada, tidak boleh duplikat")
-				return errFieldSchedule.ErrFieldScheduleIsExist
-			}
+	if len(fieldSchedules) == 0 {
+		return []dto.FieldAvailabilityResponse{}, nil
+	}
 
-			// ➕ Step 8: Tambahkan schedule baru ke wadahnya
-			fieldSchedules = append(fieldSchedules, models.FieldSchedule{
-				UUID:    uuid.New(),
-				FieldID: field.ID,
-				TimeID:  item.ID,
-				Date:    currentDate,
-				Status:  constants.Available,
-			})
+	// 3️⃣ Ambil pricing rule untuk semua field sekaligus, lalu bagi per field
+	fieldIDs := make([]uint, 0)
+	for _, schedule := range fieldSchedules {
+		if !slices.Contains(fieldIDs, schedule.FieldID) {
+			fieldIDs = append(fieldIDs, schedule.FieldID)
 		}
 	}
-	fmt.Printf("💾 [INFO-FIELD-SCHEDULE-SERVICE] Siap simpan %d schedule baru ke database\n", len(fieldSchedules))
 
-	// 🗃️ Step 9: Simpan ke DB
-	err = f.repository.GetFieldSchedule().Create(ctx, fieldSchedules)
+	rules, err := f.repository.GetPricingRule().FindActiveByFieldIDsAndDate(ctx, fieldIDs, param.Date)
 	if err != nil {
-		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal simpan schedule:", err)
-		return err
+		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal ambil pricing rule:", err)
+		return nil, err
 	}
 
-	fmt.Println("✅ [INFO-FIELD-SCHEDULE-SERVICE] FieldSchedules berhasil disimpan")
+	engines := make(map[uint]*pricingService.PricingEngine, len(fieldIDs))
+	for _, fieldID := range fieldIDs {
+		fieldRules := make([]models.PricingRule, 0, len(rules))
+		for _, rule := range rules {
+			if rule.FieldID == nil || *rule.FieldID == fieldID {
+				fieldRules = append(fieldRules, rule)
+			}
+		}
+		engines[fieldID] = pricingService.NewPricingEngine(fieldRules)
+	}
 
-	// 🏁 End debug
-	return nil
-}
+	// 4️⃣ Kelompokkan slot per field dan filter berdasarkan harga efektif
+	// 📝 Catatan:
+	// Field yang semua slotnya tersaring harga tidak ikut dikembalikan.
+	results := make([]dto.FieldAvailabilityResponse, 0, len(fieldIDs))
+	indexByFieldID := make(map[uint]int, len(fieldIDs))
+	for _, schedule := range fieldSchedules {
+		price := engines[schedule.FieldID].EffectivePrice(schedule.Field.PricePerHour, date, schedule.Time.StartTime)
+		if param.MinPrice != nil && price < *param.MinPrice {
+			continue
+		}
+		if param.MaxPrice != nil && price > *param.MaxPrice {
+			continue
+		}
 
-func (f *FieldScheduleService) Create(ctx context.Context, request *dto.FieldScheduleRequest) error {
-	// 🚀 Start debug
-	fmt.Println("🚀 [DEBUG-FIELD-SCHEDULE-SERVICE] Create - Start")
-	fmt.Printf("📥 [DEBUG-FIELD-SCHEDULE-SERVICE] Input request: %+v\n", request)
+		index, ok := indexByFieldID[schedule.FieldID]
+		if !ok {
+			results = append(results, dto.FieldAvailabilityResponse{
+				UUID:         schedule.Field.UUID,
+				Code:         schedule.Field.Code,
+				Name:         schedule.Field.Name,
+				SportType:    schedule.Field.SportType,
+				Surface:      schedule.Field.Surface,
+				IsIndoor:     schedule.Field.IsIndoor,
+				PricePerHour: schedule.Field.PricePerHour,
+				Venue:        f.venueResponse(schedule.Field.Venue),
+				Date:         param.Date,
+				Slots:        make([]dto.AvailableFieldScheduleSlot, 0),
+			})
+			index = len(results) - 1
+			indexByFieldID[schedule.FieldID] = index
+		}
 
-	// ✅ Step 1: Cek field (lapangan) ada atau tidak
-	field, err := f.repository.GetField().FindByUUID(ctx, request.FieldID)
-	if err != nil {
-		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal ambil field:", err)
-		return err
+		results[index].Slots = append(results[index].Slots, dto.AvailableFieldScheduleSlot{
+			UUID:         schedule.UUID,
+			StartTime:    schedule.Time.StartTime,
+			EndTime:      schedule.Time.EndTime,
+			PricePerHour: price,
+		})
 	}
-	fmt.Printf("✅ [INFO-FIELD-SCHEDULE-SERVICE] Field ditemukan: %+v\n", field)
-
-	//Step 2: Buat wadah kosong untuk menampung daftar jadwal baru
-	fieldSchedules := make([]models.FieldSchedule, 0, len(request.TimeIDs))
-	fmt.Println("📦 [DEBUG-FIELD-SCHEDULE-SERVICE] Menyiapkan wadah kosong untuk kumpulkan jadwal (fieldSchedules)")
 
-	dateParsed, _ := time.Parse(time.DateOnly, request.Date)
-	fmt.Println("📆 [DEBUG-FIELD-SCHEDULE-SERVICE] Parsed date:", dateParsed)
+	fmt.Printf("✅ [INFO-FIELD-SCHEDULE-SERVICE] SearchAvailability selesai: %d field\n", len(results))
+	return results, nil
+}
 
-	// 🔄 Step 3: Looping TimeIDs, cek, dan siapkan jadwal
-	for _, timeId := range request.TimeIDs {
-		fmt.Println("🔄 [DEBUG-FIELD-SCHEDULE-SERVICE] Loop TimeID:", timeId)
+func (f *FieldScheduleService) venueResponse(venue *models.Venue) *dto.FieldVenueResponse {
+	if venue == nil {
+		return nil
+	}
+	return &dto.FieldVenueResponse{
+		UUID: venue.UUID,
+		Name: venue.Name,
+	}
+}
 
-		// ⏰ Ambil scheduleTime
-		scheduleTime, err := f.repository.GetTime().FindByUUID(ctx, timeId)
-		if err != nil {
-			fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal ambil scheduleTime/ jamnya tidak ketemu:", err)
-			return err
-		}
-		fmt.Printf("✅ [INFO-FIELD-SCHEDULE-SERVICE] scheduleTime ditemukan: %+v\n", scheduleTime)
+func (f *FieldScheduleService) GenerateScheduleForOneMonth(
+	ctx context.Context,
+	request *dto.GenerateFieldScheduleForOneMonthRequest,
+) (*dto.GenerateFieldScheduleResponse, error) {
+	// 🚀 Start debug
+	fmt.Println("🚀 [DEBUG-FIELD-SCHEDULE-SERVICE] GenerateScheduleForOneMonth - Start")
+	fmt.Printf("📥 [DEBUG-FIELD-SCHEDULE-SERVICE] Input request: %+v\n", request)
 
-		// 🔍 Cek apakah schedule sudah ada (duplikat)
-		schedule, err := f.repository.GetFieldSchedule().FindByDateAndTimeID(ctx, request.Date, int(scheduleTime.ID), int(field.ID))
+	// 📝 Catatan:
+	// Cek field, katalog, closure dan insert jadwal berjalan dalam satu transaksi,
+	// jadi kalau ada langkah yang gagal tidak ada jadwal yang setengah tersimpan.
+	var response *dto.GenerateFieldScheduleResponse
+	err := f.repository.WithTransaction(ctx, func(repository repositories.IRepositoryRegistry) error {
+		txService := f.withRepository(repository)
+
+		// ✅ Ambil field untuk tahu timezone venue-nya
+		field, err := repository.GetField().FindByUUID(ctx, request.FieldID)
 		if err != nil {
-			fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal cek schedule existing:", err)
+			fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal ambil field:", err)
 			return err
 		}
-		if schedule != nil {
-			fmt.Println("⚠️ [WARN-FIELD-SCHEDULE-SERVICE] Schedule sudah ada, tidak boleh duplikat")
-			return errFieldSchedule.ErrFieldScheduleIsExist
-		}
-
-		// ➕ Tambahkan schedule baru ke slice
-		fieldSchedules = append(fieldSchedules, models.FieldSchedule{
-			UUID:    uuid.New(),
-			FieldID: field.ID,
-			Date:    dateParsed,
-			TimeID:  scheduleTime.ID,
-			Status:  constants.Available,
-		})
-		fmt.Printf("➕ [DEBUG-FIELD-SCHEDULE-SERVICE] Schedule baru ditambahkan: %+v\n", fieldSchedules[len(fieldSchedules)-1])
-	}
 
-	// 🗃️ Step 4: Simpan ke DB
-	fmt.Printf("💾 [INFO-FIELD-SCHEDULE-SERVICE] Siap simpan %d schedule baru ke database\n", len(fieldSchedules))
+		// ✅ Tentukan jumlah hari (30 hari dari hari besok), semua time slot berlaku setiap hari
+		// 📝 Catatan:
+		// "Besok" dihitung di timezone venue, jadi venue WIT tidak tertinggal satu hari dari venue WIB.
+		numberOfDays := 30
+		startDate := txService.tomorrow(field)
+		endDate := startDate.AddDate(0, 0, numberOfDays-1)
+		fmt.Printf("📆 [DEBUG-FIELD-SCHEDULE-SERVICE] Generate schedule mulai dari besok: %s untuk %d hari\n", startDate.Format(time.DateOnly), numberOfDays)
 
-	err = f.repository.GetFieldSchedule().Create(ctx, fieldSchedules)
-	if err != nil {
-		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal simpan schedule:", err)
+		response, err = txService.generateSchedule(ctx, request.FieldID, startDate, endDate, nil, request.SkipExisting)
 		return err
+	})
+	if err != nil {
+		return nil, err
 	}
-	fmt.Println("✅ [INFO-FIELD-SCHEDULE-SERVICE] FieldSchedules berhasil disimpan")
 
-	// 🏁 End debug
-	fmt.Println("🏁 [DEBUG-FIELD-SCHEDULE-SERVICE] Create - End sukses")
-	return nil
+	return response, nil
 }
 
-func (f *FieldScheduleService) Update(
+// withRepository mengembalikan FieldScheduleService yang memakai registry repository lain,
+// dipakai supaya helper service ikut berjalan di transaksi dari WithTransaction.
+func (f *FieldScheduleService) withRepository(repository repositories.IRepositoryRegistry) *FieldScheduleService {
+	return &FieldScheduleService{repository: repository}
+}
+
+func (f *FieldScheduleService) Generate(
 	ctx context.Context,
-	uuid string,
-	request *dto.UpdateFieldScheduleRequest,
-) (*dto.FieldScheduleResponse, error) {
+	request *dto.GenerateFieldScheduleRequest,
+) (*dto.GenerateFieldScheduleResponse, error) {
 	// 🚀 Start debug
-	fmt.Println("🚀 [DEBUG-FIELD-SCHEDULE-SERVICE] Update - Start")
-	fmt.Printf("📥 [DEBUG-FIELD-SCHEDULE-SERVICE] UUID: %s\n", uuid)
+	fmt.Println("🚀 [DEBUG-FIELD-SCHEDULE-SERVICE] Generate - Start")
 	fmt.Printf("📥 [DEBUG-FIELD-SCHEDULE-SERVICE] Input request: %+v\n", request)
 
-	// ✅ Step 1: Ambil data fieldSchedule berdasarkan UUID
-	fieldSchedule, err := f.repository.GetFieldSchedule().FindByUUID(ctx, uuid)
+	// ✅ Step 1: Parse dan validasi rentang tanggal
+	startDate, err := time.Parse(time.DateOnly, request.StartDate)
 	if err != nil {
-		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal ambil data fieldSchedule:", err)
-		return nil, err
+		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] StartDate tidak valid:", err)
+		return nil, errFieldSchedule.ErrInvalidDateRange
 	}
 
-	// ✅ Step 2: Ambil data waktu berdasarkan UUID
-	scheduleTime, err := f.repository.GetTime().FindByUUID(ctx, request.TimeID)
+	endDate, err := time.Parse(time.DateOnly, request.EndDate)
 	if err != nil {
-		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal ambil data waktu:", err)
-		return nil, err
+		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] EndDate tidak valid:", err)
+		return nil, errFieldSchedule.ErrInvalidDateRange
 	}
-	fmt.Printf("✅ [INFO-FIELD-SCHEDULE-SERVICE] Data waktunya ditemukan: %+v\n", scheduleTime)
 
-	// ✅ Step 3: Buat query untuk cek apakah jadwal berdasarkan tanggal dan timeID sudah ada/ belum
-	isTimeExist, err := f.repository.GetFieldSchedule().FindByDateAndTimeID(
+	// 📝 Catatan:
+	// EndDate tidak boleh sebelum StartDate dan rentangnya dibatasi supaya
+	// satu request tidak membuat jutaan baris sekaligus.
+	if endDate.Before(startDate) || endDate.Sub(startDate) > maxGenerateDays*24*time.Hour {
+		fmt.Println("⚠️ [WARN-FIELD-SCHEDULE-SERVICE] Rentang tanggal tidak valid:", startDate, endDate)
+		return nil, errFieldSchedule.ErrInvalidDateRange
+	}
+
+	return f.generateSchedule(ctx, request.FieldID, startDate, endDate, request.WeekdayTemplates, request.SkipExisting)
+}
+
+// GenerateRolling memastikan setiap field punya jadwal sampai daysAhead hari ke depan (mulai besok).
+// Dijalankan oleh job malam hari; advisory lock menjaga supaya hanya satu replica yang generate.
+// Slot yang sudah ada dilewati, jadi aman dijalankan berulang kali.
+func (f *FieldScheduleService) GenerateRolling(ctx context.Context, daysAhead int) (*dto.GenerateFieldScheduleResponse, error) {
+	fmt.Println("🚀 [DEBUG-FIELD-SCHEDULE-SERVICE] GenerateRolling - Start, daysAhead:", daysAhead)
+
+	report := &dto.GenerateFieldScheduleResponse{}
+	acquired, err := f.repository.GetLock().WithAdvisoryLock(
 		ctx,
-		request.Date,
-		int(scheduleTime.ID),
-		int(fieldSchedule.FieldID),
+		constants.ScheduleGeneratorLockKey,
+		func(ctx context.Context) error {
+			// ✅ Step 1: Ambil semua field
+			fields, err := f.repository.GetField().FindAllWithoutPagination(ctx, nil)
+			if err != nil {
+				fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal ambil field:", err)
+				return err
+			}
+
+			// ✅ Step 2: Generate jadwal yang belum ada untuk setiap field
+			// 📝 Catatan:
+			// Kalau satu field gagal, field lain tetap diproses; error pertama dikembalikan di akhir.
+			// "Besok" dihitung per field sesuai timezone venue-nya.
+			var firstErr error
+			for _, field := range fields {
+				startDate := f.tomorrow(&field)
+				endDate := startDate.AddDate(0, 0, daysAhead-1)
+				result, err := f.generateSchedule(ctx, field.UUID.String(), startDate, endDate, nil, true)
+				if err != nil {
+					fmt.Printf("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal generate schedule field %s: %v\n", field.UUID, err)
+					if firstErr == nil {
+						firstErr = err
+					}
+					continue
+				}
+				report.Created += result.Created
+				report.Skipped += result.Skipped
+			}
+			return firstErr
+		},
 	)
 	if err != nil {
-		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal cek jadwal:", err)
+		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] GenerateRolling gagal:", err)
 		return nil, err
 	}
 
-	if isTimeExist != nil && request.Date != fieldSchedule.Date.Format(time.DateOnly) {
-		checkDate, err := f.repository.GetFieldSchedule().FindByDateAndTimeID(
-			ctx,
-			request.Date,
-			int(scheduleTime.ID),
-			int(fieldSchedule.FieldID),
-		)
-		if err != nil {
-			fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal cek jadwal:", err)
-			return nil, err
-		}
-
-		if checkDate != nil {
-			fmt.Println("⚠️ [WARN-FIELD-SCHEDULE-SERVICE] Jadwal sudah ada pada tanggal yang baru")
-			return nil, errFieldSchedule.ErrFieldScheduleIsExist
-		}
+	if !acquired {
+		fmt.Println("⚠️ [WARN-FIELD-SCHEDULE-SERVICE] GenerateRolling dilewati, replica lain sedang berjalan")
+		return report, nil
 	}
 
-	dateParesed, _ := time.Parse(time.DateOnly, request.Date)
-	fieldResult, err := f.repository.GetFieldSchedule().Update(ctx, uuid, &models.FieldSchedule{
-		Date:   dateParesed,
-		TimeID: scheduleTime.ID,
-	})
+	fmt.Printf("🏁 [DEBUG-FIELD-SCHEDULE-SERVICE] GenerateRolling - End: %+v\n", report)
+	return report, nil
+}
 
+// generateSchedule membuat jadwal Available untuk fieldID dari startDate sampai endDate (inklusif).
+// Kalau templates kosong, semua time slot di katalog field berlaku setiap hari. Hari yang ada di templates
+// hanya memakai TimeIDs-nya (list kosong artinya tutup), hari lain memakai semua time slot.
+func (f *FieldScheduleService) generateSchedule(
+	ctx context.Context,
+	fieldID string,
+	startDate time.Time,
+	endDate time.Time,
+	templates []dto.WeekdayTemplateRequest,
+	skipExisting bool,
+) (*dto.GenerateFieldScheduleResponse, error) {
+	// ✅ Step 1: Cek field (lapangan) ada atau tidak
+	field, err := f.repository.GetField().FindByUUID(ctx, fieldID)
 	if err != nil {
-		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal update fieldSchedule:", err)
+		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal ambil field:", err)
 		return nil, err
 	}
+	fmt.Printf("✅ [INFO-FIELD-SCHEDULE-SERVICE] Field ditemukan: %+v\n", field)
 
-	// ✅ Step 4: Buat response
-	response := dto.FieldScheduleResponse{
-		UUID:         fieldResult.UUID,
-		FieldName:    fieldResult.Field.Name,
-		Date:         fieldResult.Date.Format(time.DateOnly),
-		PricePerHour: fieldResult.Field.PricePerHour,
-		Status:       fieldResult.Status.GetStatusString(),
-		Time:         fmt.Sprintf("%s - %s", scheduleTime.StartTime, scheduleTime.EndTime),
-		CreatedAt:    fieldResult.CreatedAt,
-		UpdatedAt:    fieldResult.UpdatedAt,
+	// ✅ Step 2: Ambil katalog time slot milik field ini (atau katalog global)
+	times, err := f.repository.GetTime().FindCatalogByFieldID(ctx, field.ID)
+	if err != nil {
+		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal ambil time:", err)
+		return nil, err
 	}
+	fmt.Printf("✅ [INFO-FIELD-SCHEDULE-SERVICE] Time ditemukan: %+v\n", len(times))
 
-	fmt.Printf("✅ [INFO-FIELD-SCHEDULE-SERVICE] Response yang dikembalikan: %+v\n", response)
-	fmt.Println("🏁 [DEBUG-FIELD-SCHEDULE-SERVICE] Update - End sukses")
-	return &response, nil
-}
+	// ✅ Step 3: Susun template time slot per hari (weekday)
+	weekdayTimes, err := f.buildWeekdayTimes(times, templates)
+	if err != nil {
+		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Template weekday tidak valid:", err)
+		return nil, err
+	}
 
-func (f *FieldScheduleService) UpdateStatus(ctx context.Context, request *dto.UpdateStatusFieldScheduleRequest) error {
-	// 🚀 [DEBUG-FIELD-SCHEDULE-SERVICE] Mulai function UpdateStatus
-	fmt.Println("🚀 [DEBUG-FIELD-SCHEDULE-SERVICE] Start UpdateStatus")
-	fmt.Printf("📥 [DEBUG-FIELD-SCHEDULE-SERVICE] Input request: %+v\n", request)
+	// ✅ Step 4: Ambil closure (hari libur/penutupan) yang berlaku di rentang ini
+	closedDates, err := f.closedDates(ctx, field.UUID.String(), startDate, endDate)
+	if err != nil {
+		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal ambil closure:", err)
+		return nil, err
+	}
 
-	// 1️⃣ Loop semua FieldScheduleIDs (karena bentuknya array/list)
-	for _, item := range request.FieldScheduleIDs {
-		fmt.Printf("🔄 [DEBUG-FIELD-SCHEDULE-SERVICE] Proses FieldScheduleID: %s\n", item)
+	// ✅ Step 5: Buat wadah kosong untuk menampung daftar jadwal baru
+	numberOfDays := int(endDate.Sub(startDate).Hours()/24) + 1
+	fieldSchedules := make([]models.FieldSchedule, 0, numberOfDays*len(times))
+	fmt.Println("📦 [DEBUG-FIELD-SCHEDULE-SERVICE] Wadah kosong untuk jadwal sudah disiapkan")
 
-		// 2️⃣ Cek apakah fieldSchedule dengan ID itu ada di database
-		_, err := f.repository.GetFieldSchedule().FindByUUID(ctx, item)
-		if err != nil {
-			fmt.Printf("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Data tidak ditemukan (ID: %s): %v\n", item, err)
-			return fmt.Errorf("gagal update schedule dengan ID %s: %w", item, err)
+	// 🔄 Step 6: Loop untuk semua tanggal dari startDate sampai endDate
+	for i := 0; i < numberOfDays; i++ {
+		currentDate := startDate.AddDate(0, 0, i)
+		fmt.Printf("🔄 [DEBUG-FIELD-SCHEDULE-SERVICE] Tanggal yang diproses: %s\n", currentDate.Format(time.DateOnly))
+
+		if closedDates[currentDate.Format(time.DateOnly)] {
+			fmt.Println("⛔ [DEBUG-FIELD-SCHEDULE-SERVICE] Tanggal tutup (closure), dilewati")
+			continue
 		}
-		fmt.Printf("✅ [INFO-FIELD-SCHEDULE-SERVICE] Data ditemukan, lanjut update: %s\n", item)
 
-		// 3️⃣ Update status jadi booked
-		err = f.repository.GetFieldSchedule().UpdateStatus(ctx, constants.Booked, item)
-		if err != nil {
-			fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal update status:", err)
-			return err
+		// 🔄 Step 7: Loop untuk time slot yang berlaku di hari tersebut
+		dayTimes, ok := weekdayTimes[currentDate.Weekday()]
+		if !ok {
+			dayTimes = times
 		}
-		fmt.Printf("✅ [INFO-FIELD-SCHEDULE-SERVICE] Status berhasil diupdate jadi booked: %s\n", item)
-	}
-	// 4️⃣ Selesai, return success kalau semua berhasil
-	fmt.Println("✅ [INFO-FIELD-SCHEDULE-SERVICE] Semua status berhasil diupdate")
-	fmt.Println("🏁 [DEBUG-FIELD-SCHEDULE-SERVICE] End UpdateStatus sukses")
-	return nil
+
+		for _, item := range dayTimes {
+			fmt.Printf("🔄 [DEBUG-FIELD-SCHEDULE-SERVICE] Proses TimeSlot: %s (TimeID: %d)\n", item.StartTime, item.ID)
+
+			// ➕ Step 8: Tambahkan schedule baru ke wadahnya
+			fieldSchedules = append(fieldSchedules, models.FieldSchedule{
+				UUID:    uuid.New(),
+				FieldID: field.ID,
+				TimeID:  item.ID,
+				Date:    currentDate,
+				Status:  constants.Available,
+			})
+		}
+	}
+	fmt.Printf("💾 [INFO-FIELD-SCHEDULE-SERVICE] Siap simpan %d schedule baru ke database\n", len(fieldSchedules))
+
+	// 🗃️ Step 9: Simpan ke DB
+	// 📝 Catatan:
+	// Duplikat dicek oleh unique index (field_id, date, time_id), bukan query per slot.
+	return f.saveSchedules(ctx, fieldSchedules, skipExisting)
 }
 
-func (f *FieldScheduleService) Delete(ctx context.Context, uuid string) error {
-	// 🚀 [DEBUG-FIELD-SCHEDULE-SERVICE] Mulai function Delete
-	fmt.Println("🚀 [DEBUG-FIELD-SCHEDULE-SERVICE] Start Delete")
+// saveSchedules menyimpan jadwal baru. Kalau skipExisting true, slot yang sudah ada dilewati
+// (INSERT ... ON CONFLICT DO NOTHING) sehingga generate aman dijalankan ulang; kalau false,
+// satu slot yang sudah ada membatalkan seluruh request dengan ErrFieldScheduleIsExist.
+func (f *FieldScheduleService) saveSchedules(
+	ctx context.Context,
+	fieldSchedules []models.FieldSchedule,
+	skipExisting bool,
+) (*dto.GenerateFieldScheduleResponse, error) {
+	fmt.Printf("💾 [INFO-FIELD-SCHEDULE-SERVICE] Siap simpan %d schedule baru ke database (skipExisting: %v)\n", len(fieldSchedules), skipExisting)
+
+	// 📝 Catatan:
+	// Kalau semua hari di template tutup, tidak ada yang perlu disimpan.
+	if len(fieldSchedules) == 0 {
+		fmt.Println("⚠️ [WARN-FIELD-SCHEDULE-SERVICE] Tidak ada schedule yang perlu dibuat")
+		return &dto.GenerateFieldScheduleResponse{}, nil
+	}
+
+	if !skipExisting {
+		err := f.repository.GetFieldSchedule().Create(ctx, fieldSchedules)
+		if err != nil {
+			fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal simpan schedule:", err)
+			return nil, err
+		}
+
+		fmt.Println("✅ [INFO-FIELD-SCHEDULE-SERVICE] FieldSchedules berhasil disimpan")
+		return &dto.GenerateFieldScheduleResponse{Created: len(fieldSchedules)}, nil
+	}
+
+	created, err := f.repository.GetFieldSchedule().CreateSkipExisting(ctx, fieldSchedules)
+	if err != nil {
+		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal simpan schedule:", err)
+		return nil, err
+	}
+
+	response := &dto.GenerateFieldScheduleResponse{
+		Created: int(created),
+		Skipped: len(fieldSchedules) - int(created),
+	}
+	fmt.Printf("✅ [INFO-FIELD-SCHEDULE-SERVICE] FieldSchedules berhasil disimpan: %+v\n", response)
+	return response, nil
+}
+
+// fieldLocation mengembalikan timezone venue field, atau constants.DefaultTimezone kalau field belum punya venue.
+func (f *FieldScheduleService) fieldLocation(field *models.Field) *time.Location {
+	if field.Venue == nil {
+		return util.LoadLocation(constants.DefaultTimezone)
+	}
+	return util.LoadLocation(field.Venue.Timezone)
+}
+
+// tomorrow mengembalikan tanggal besok menurut timezone venue field.
+func (f *FieldScheduleService) tomorrow(field *models.Field) time.Time {
+	return util.DateIn(time.Now(), f.fieldLocation(field)).AddDate(0, 0, 1)
+}
+
+func (f *FieldScheduleService) inLocation(t *time.Time, loc *time.Location) *time.Time {
+	if t == nil {
+		return nil
+	}
+	local := t.In(loc)
+	return &local
+}
+
+// pricingEngine menyiapkan pricing engine berisi rule aktif untuk fieldID di tanggal date.
+func (f *FieldScheduleService) pricingEngine(
+	ctx context.Context,
+	fieldID uint,
+	date time.Time,
+) (*pricingService.PricingEngine, error) {
+	rules, err := f.repository.GetPricingRule().FindActiveByFieldIDAndDate(ctx, fieldID, date.Format(time.DateOnly))
+	if err != nil {
+		return nil, err
+	}
+	return pricingService.NewPricingEngine(rules), nil
+}
+
+// effectivePricer membuat penghitung harga efektif per jadwal. Pricing engine di-cache per
+// field + tanggal supaya rule cukup di-query sekali untuk jadwal di hari yang sama.
+// Field dan Time pada jadwal harus sudah di-preload.
+func (f *FieldScheduleService) effectivePricer(ctx context.Context) fieldScheduleRepositories.FieldSchedulePricer {
+	engines := make(map[string]*pricingService.PricingEngine)
+	return func(item models.FieldSchedule) (int, error) {
+		key := fmt.Sprintf("%d|%s", item.FieldID, item.Date.Format(time.DateOnly))
+		engine, ok := engines[key]
+		if !ok {
+			var err error
+			engine, err = f.pricingEngine(ctx, item.FieldID, item.Date)
+			if err != nil {
+				return 0, err
+			}
+			engines[key] = engine
+		}
+		return engine.EffectivePrice(item.Field.PricePerHour, item.Date, item.Time.StartTime), nil
+	}
+}
+
+// schedulePricer seperti effectivePricer, tapi memakai snapshot harga booking kalau ada.
+func (f *FieldScheduleService) schedulePricer(ctx context.Context) fieldScheduleRepositories.FieldSchedulePricer {
+	effectivePrice := f.effectivePricer(ctx)
+	return func(item models.FieldSchedule) (int, error) {
+		if item.PricePerHour != nil {
+			return *item.PricePerHour, nil
+		}
+		return effectivePrice(item)
+	}
+}
+
+// closedDates mengembalikan set tanggal (format DateOnly) yang ditutup oleh closure untuk fieldID.
+func (f *FieldScheduleService) closedDates(
+	ctx context.Context,
+	fieldID string,
+	startDate time.Time,
+	endDate time.Time,
+) (map[string]bool, error) {
+	closures, err := f.repository.GetClosure().FindOverlappingByFieldID(
+		ctx,
+		fieldID,
+		startDate.Format(time.DateOnly),
+		endDate.Format(time.DateOnly),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	dates := make(map[string]bool)
+	for _, closure := range closures {
+		for date := closure.StartDate; !date.After(closure.EndDate); date = date.AddDate(0, 0, 1) {
+			dates[date.Format(time.DateOnly)] = true
+		}
+	}
+	return dates, nil
+}
+
+// buildWeekdayTimes mengubah template weekday dari request menjadi map weekday -> time slot.
+func (f *FieldScheduleService) buildWeekdayTimes(
+	times []models.Time,
+	templates []dto.WeekdayTemplateRequest,
+) (map[time.Weekday][]models.Time, error) {
+	timeByUUID := make(map[string]models.Time, len(times))
+	for _, item := range times {
+		timeByUUID[item.UUID.String()] = item
+	}
+
+	weekdayTimes := make(map[time.Weekday][]models.Time, len(templates))
+	for _, template := range templates {
+		weekday := time.Weekday(template.Weekday)
+		dayTimes := make([]models.Time, 0, len(template.TimeIDs))
+		for _, timeID := range template.TimeIDs {
+			item, ok := timeByUUID[timeID]
+			if !ok {
+				return nil, errTime.ErrTimeNotInCatalog
+			}
+			dayTimes = append(dayTimes, item)
+		}
+		weekdayTimes[weekday] = append(weekdayTimes[weekday], dayTimes...)
+	}
+
+	return weekdayTimes, nil
+}
+
+func (f *FieldScheduleService) Create(
+	ctx context.Context,
+	request *dto.FieldScheduleRequest,
+) (*dto.GenerateFieldScheduleResponse, error) {
+	// 🚀 Start debug
+	fmt.Println("🚀 [DEBUG-FIELD-SCHEDULE-SERVICE] Create - Start")
+	fmt.Printf("📥 [DEBUG-FIELD-SCHEDULE-SERVICE] Input request: %+v\n", request)
+
+	// 📝 Catatan:
+	// Cek field, cek katalog waktu dan insert jadwal berjalan dalam satu transaksi.
+	var response *dto.GenerateFieldScheduleResponse
+	err := f.repository.WithTransaction(ctx, func(repository repositories.IRepositoryRegistry) error {
+		var err error
+		response, err = f.withRepository(repository).create(ctx, request)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 🏁 End debug
+	fmt.Println("🏁 [DEBUG-FIELD-SCHEDULE-SERVICE] Create - End sukses")
+	return response, nil
+}
+
+// create berisi langkah-langkah Create; dipanggil di dalam transaksi oleh Create.
+func (f *FieldScheduleService) create(
+	ctx context.Context,
+	request *dto.FieldScheduleRequest,
+) (*dto.GenerateFieldScheduleResponse, error) {
+	// ✅ Step 1: Cek field (lapangan) ada atau tidak
+	field, err := f.repository.GetField().FindByUUID(ctx, request.FieldID)
+	if err != nil {
+		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal ambil field:", err)
+		return nil, err
+	}
+	fmt.Printf("✅ [INFO-FIELD-SCHEDULE-SERVICE] Field ditemukan: %+v\n", field)
+
+	//Step 2: Buat wadah kosong untuk menampung daftar jadwal baru
+	fieldSchedules := make([]models.FieldSchedule, 0, len(request.TimeIDs))
+	fmt.Println("📦 [DEBUG-FIELD-SCHEDULE-SERVICE] Menyiapkan wadah kosong untuk kumpulkan jadwal (fieldSchedules)")
+
+	dateParsed, _ := time.Parse(time.DateOnly, request.Date)
+	fmt.Println("📆 [DEBUG-FIELD-SCHEDULE-SERVICE] Parsed date:", dateParsed)
+
+	// ⏰ Time slot yang dipilih harus ada di katalog waktu field ini
+	catalog, err := f.repository.GetTime().FindCatalogByFieldID(ctx, field.ID)
+	if err != nil {
+		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal ambil katalog waktu:", err)
+		return nil, err
+	}
+
+	catalogIDs := make(map[uint]bool, len(catalog))
+	for _, item := range catalog {
+		catalogIDs[item.ID] = true
+	}
+
+	// 🔄 Step 3: Looping TimeIDs, cek, dan siapkan jadwal
+	for _, timeId := range request.TimeIDs {
+		fmt.Println("🔄 [DEBUG-FIELD-SCHEDULE-SERVICE] Loop TimeID:", timeId)
+
+		// ⏰ Ambil scheduleTime
+		scheduleTime, err := f.repository.GetTime().FindByUUID(ctx, timeId)
+		if err != nil {
+			fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal ambil scheduleTime/ jamnya tidak ketemu:", err)
+			return nil, err
+		}
+		fmt.Printf("✅ [INFO-FIELD-SCHEDULE-SERVICE] scheduleTime ditemukan: %+v\n", scheduleTime)
+
+		if !catalogIDs[scheduleTime.ID] {
+			fmt.Println("⚠️ [WARN-FIELD-SCHEDULE-SERVICE] scheduleTime bukan katalog field ini:", timeId)
+			return nil, errTime.ErrTimeNotInCatalog
+		}
+
+		// ➕ Tambahkan schedule baru ke slice
+		fieldSchedules = append(fieldSchedules, models.FieldSchedule{
+			UUID:    uuid.New(),
+			FieldID: field.ID,
+			Date:    dateParsed,
+			TimeID:  scheduleTime.ID,
+			Status:  constants.Available,
+		})
+		fmt.Printf("➕ [DEBUG-FIELD-SCHEDULE-SERVICE] Schedule baru ditambahkan: %+v\n", fieldSchedules[len(fieldSchedules)-1])
+	}
+
+	// 🗃️ Step 4: Simpan ke DB (duplikat dicek oleh unique index)
+	return f.saveSchedules(ctx, fieldSchedules, request.SkipExisting)
+}
+
+func (f *FieldScheduleService) Update(
+	ctx context.Context,
+	uuid string,
+	request *dto.UpdateFieldScheduleRequest,
+) (*dto.FieldScheduleResponse, error) {
+	// 🚀 Start debug
+	fmt.Println("🚀 [DEBUG-FIELD-SCHEDULE-SERVICE] Update - Start")
 	fmt.Printf("📥 [DEBUG-FIELD-SCHEDULE-SERVICE] UUID: %s\n", uuid)
+	fmt.Printf("📥 [DEBUG-FIELD-SCHEDULE-SERVICE] Input request: %+v\n", request)
 
-	// 1️⃣ Cek apakah fieldSchedule dengan UUID itu ada?
-	_, err := f.repository.GetFieldSchedule().FindByUUID(ctx, uuid)
+	// 📝 Catatan:
+	// Semua pengecekan (jadwal, field tujuan, katalog waktu, harga) dan update dibaca lewat repository
+	// transaksi yang sama, jadi data yang dicek sama dengan data saat jadwal ditulis.
+	var results []dto.FieldScheduleResponse
+	err := f.repository.WithTransaction(ctx, func(repository repositories.IRepositoryRegistry) error {
+		txService := f.withRepository(repository)
+
+		// ✅ Step 1: Ambil data fieldSchedule berdasarkan UUID
+		fieldSchedule, err := repository.GetFieldSchedule().FindByUUID(ctx, uuid)
+		if err != nil {
+			fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal ambil data fieldSchedule:", err)
+			return err
+		}
+
+		// ✅ Step 2: Tentukan field tujuan (FieldID kosong artinya tetap di field yang sama)
+		field := &fieldSchedule.Field
+		if request.FieldID != nil {
+			field, err = repository.GetField().FindByUUID(ctx, *request.FieldID)
+			if err != nil {
+				fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal ambil data field tujuan:", err)
+				return err
+			}
+		}
+
+		// ✅ Step 3: Ambil data waktu berdasarkan UUID, harus ada di katalog waktu field tujuan
+		scheduleTime, err := repository.GetTime().FindByUUID(ctx, request.TimeID)
+		if err != nil {
+			fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal ambil data waktu:", err)
+			return err
+		}
+		fmt.Printf("✅ [INFO-FIELD-SCHEDULE-SERVICE] Data waktunya ditemukan: %+v\n", scheduleTime)
+
+		catalog, err := repository.GetTime().FindCatalogByFieldID(ctx, field.ID)
+		if err != nil {
+			fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal ambil katalog waktu field:", err)
+			return err
+		}
+
+		inCatalog := slices.ContainsFunc(catalog, func(item models.Time) bool {
+			return item.ID == scheduleTime.ID
+		})
+		if !inCatalog {
+			fmt.Println("⚠️ [WARN-FIELD-SCHEDULE-SERVICE] scheduleTime bukan katalog field tujuan:", request.TimeID)
+			return errTime.ErrTimeNotInCatalog
+		}
+
+		// ✅ Step 4: Update di repository. Cek bentrok slot tujuan (selain jadwal ini),
+		// slot Booked ditolak kecuali force, dan kalau dipaksa event BookingRescheduled ikut ditulis.
+		// 📝 Catatan:
+		// Booking yang dipindah ke field lain dihitung ulang harganya.
+		dateParesed, _ := time.Parse(time.DateOnly, request.Date)
+		newTime := fmt.Sprintf("%s - %s", scheduleTime.StartTime, scheduleTime.EndTime)
+		fieldResult, err := repository.GetFieldSchedule().Update(
+			ctx,
+			uuid,
+			&models.FieldSchedule{
+				Date:    dateParesed,
+				TimeID:  scheduleTime.ID,
+				FieldID: field.ID,
+			},
+			txService.bookedGuard(request.Force),
+			txService.bookingEvent(
+				constants.FieldScheduleBookingRescheduled,
+				request.Reason,
+				request.ChangedBy,
+				&field.UUID,
+				&dateParesed,
+				&newTime,
+			),
+			txService.effectivePricer(ctx),
+		)
+		if err != nil {
+			fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal update fieldSchedule:", err)
+			return err
+		}
+
+		// ✅ Step 5: Buat response
+		results, err = txService.scheduleResponses(ctx, []models.FieldSchedule{*fieldResult})
+		return err
+	})
 	if err != nil {
-		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal ambil fieldSchedule:", err)
+		return nil, err
+	}
+
+	fmt.Printf("✅ [INFO-FIELD-SCHEDULE-SERVICE] Response yang dikembalikan: %+v\n", results[0])
+	fmt.Println("🏁 [DEBUG-FIELD-SCHEDULE-SERVICE] Update - End sukses")
+	return &results[0], nil
+}
+
+func (f *FieldScheduleService) UpdateStatus(ctx context.Context, request *dto.UpdateStatusFieldScheduleRequest) error {
+	// 🚀 [DEBUG-FIELD-SCHEDULE-SERVICE] Mulai function UpdateStatus
+	fmt.Println("🚀 [DEBUG-FIELD-SCHEDULE-SERVICE] Start UpdateStatus")
+	fmt.Printf("📥 [DEBUG-FIELD-SCHEDULE-SERVICE] Input request: %+v\n", request)
+
+	// 1️⃣ Parse UUID customer yang membooking
+	userID, err := uuid.Parse(request.UserID)
+	if err != nil {
+		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] UserID tidak valid:", err)
 		return err
 	}
-	fmt.Printf("✅ [INFO-FIELD-SCHEDULE-SERVICE] FieldSchedule ditemukan: %s\n", uuid)
+
+	// 2️⃣ Buang UUID yang dobel supaya jumlah baris yang dikunci sesuai
+	fieldScheduleIDs := f.uniqueFieldScheduleIDs(request.FieldScheduleIDs)
+
+	// 3️⃣ Booking semua jadwal dalam satu transaksi (SELECT ... FOR UPDATE)
 	// 📝 Catatan:
-	// Kita pastikan fieldSchedule dengan UUID yang dikirim user itu memang ada di database.
-	// Kalau tidak ada (error), hentikan proses.
-	// Kalau ada, lanjut ke langkah berikutnya.
+	// Kalau ada satu jadwal yang tidak ditemukan, sudah Booked, atau masih di-hold customer lain,
+	// seluruh batch dibatalkan sehingga tidak ada slot yang setengah terbooking.
+	// 📝 Catatan:
+	// Harga efektif saat ini disimpan sebagai snapshot di jadwal, jadi perubahan harga nanti
+	// tidak mengubah harga booking ini (order service & invoice tetap konsisten).
+	// 📝 Catatan:
+	// Pricing rule dibaca di transaksi yang sama dengan booking, jadi snapshot harga
+	// konsisten dengan data saat slot dikunci.
+	now := time.Now()
+	err = f.repository.WithTransaction(ctx, func(repository repositories.IRepositoryRegistry) error {
+		txService := f.withRepository(repository)
+		return repository.GetFieldSchedule().BookInBatch(
+			ctx,
+			fieldScheduleIDs,
+			txService.transitionValidator(
+				constants.Booked,
+				func(item models.FieldSchedule) error {
+					if item.Status == constants.Booked {
+						return errFieldSchedule.ErrFieldScheduleIsBooked
+					}
+					return nil
+				},
+				txService.holdGuard(userID, now),
+			),
+			txService.effectivePricer(ctx),
+		)
+	})
+	if err != nil {
+		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal update status:", err)
+		return err
+	}
+
+	// 4️⃣ Selesai, return success kalau semua berhasil
+	fmt.Println("✅ [INFO-FIELD-SCHEDULE-SERVICE] Semua status berhasil diupdate")
+	fmt.Println("🏁 [DEBUG-FIELD-SCHEDULE-SERVICE] End UpdateStatus sukses")
+	return nil
+}
+
+func (f *FieldScheduleService) BookRange(
+	ctx context.Context,
+	request *dto.BookRangeFieldScheduleRequest,
+) (*dto.BookRangeFieldScheduleResponse, error) {
+	// 🚀 [DEBUG-FIELD-SCHEDULE-SERVICE] Mulai function BookRange
+	fmt.Println("🚀 [DEBUG-FIELD-SCHEDULE-SERVICE] Start BookRange")
+	fmt.Printf("📥 [DEBUG-FIELD-SCHEDULE-SERVICE] Input request: %+v\n", request)
+
+	// 1️⃣ Parse UUID customer yang membooking
+	userID, err := uuid.Parse(request.UserID)
+	if err != nil {
+		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] UserID tidak valid:", err)
+		return nil, err
+	}
+
+	// 2️⃣ Validasi field, tanggal dan jam mulai
+	field, err := f.repository.GetField().FindByUUID(ctx, request.FieldID)
+	if err != nil {
+		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal ambil field:", err)
+		return nil, err
+	}
+
+	_, err = time.Parse(time.DateOnly, request.Date)
+	if err != nil {
+		return nil, errFieldSchedule.ErrInvalidDateRange
+	}
+
+	startTime, err := f.parseClock(request.StartTime)
+	if err != nil {
+		return nil, errFieldSchedule.ErrInvalidTimeRange
+	}
+
+	// 3️⃣ Cari slot berurutan lalu booking semuanya dalam satu transaksi (SELECT ... FOR UPDATE)
+	// 📝 Catatan:
+	// Status dicek lagi pada baris yang sudah dikunci, jadi kalau ada slot yang keburu
+	// dibooking atau di-hold customer lain seluruh range dibatalkan. Hold milik customer
+	// ini sendiri (yang belum expired) boleh langsung dibooking.
+	// 📝 Catatan:
+	// Pencarian slot, pricing rule dan snapshot harga dibaca di transaksi yang sama dengan booking,
+	// sama seperti UpdateStatus.
+	var (
+		slots            []models.FieldSchedule
+		fieldScheduleIDs []string
+	)
+	prices := make(map[uuid.UUID]int)
+	now := time.Now()
+	err = f.repository.WithTransaction(ctx, func(repository repositories.IRepositoryRegistry) error {
+		txService := f.withRepository(repository)
+
+		fieldSchedules, err := repository.GetFieldSchedule().FindAllByFieldIDAndDate(ctx, int(field.ID), request.Date)
+		if err != nil {
+			fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal ambil field schedules:", err)
+			return err
+		}
+
+		slots, err = txService.resolveContiguousSlots(fieldSchedules, startTime, request.DurationMinutes)
+		if err != nil {
+			fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Slot tidak berurutan/tidak lengkap:", err)
+			return err
+		}
 
-	// 2️⃣ Hapus fieldSchedule berdasarkan UUID
-	err = f.repository.GetFieldSchedule().Delete(ctx, uuid)
+		fieldScheduleIDs = make([]string, 0, len(slots))
+		for _, slot := range slots {
+			fieldScheduleIDs = append(fieldScheduleIDs, slot.UUID.String())
+		}
+
+		effectivePrice := txService.effectivePricer(ctx)
+		return repository.GetFieldSchedule().BookInBatch(
+			ctx,
+			fieldScheduleIDs,
+			txService.transitionValidator(
+				constants.Booked,
+				func(item models.FieldSchedule) error {
+					if item.Status == constants.Booked {
+						return errFieldSchedule.ErrFieldScheduleIsBooked
+					}
+					return nil
+				},
+				txService.holdGuard(userID, now),
+			),
+			func(item models.FieldSchedule) (int, error) {
+				price, err := effectivePrice(item)
+				if err != nil {
+					return 0, err
+				}
+				prices[item.UUID] = price
+				return price, nil
+			},
+		)
+	})
+	if err != nil {
+		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal booking range:", err)
+		return nil, err
+	}
+
+	// 4️⃣ Susun response + total harga (harga per jam x durasi slot)
+	response := &dto.BookRangeFieldScheduleResponse{
+		FieldScheduleIDs: fieldScheduleIDs,
+		Date:             request.Date,
+		StartTime:        slots[0].Time.StartTime,
+		EndTime:          slots[len(slots)-1].Time.EndTime,
+		DurationMinutes:  request.DurationMinutes,
+		Slots:            make([]dto.BookedFieldScheduleSlots, 0, len(slots)),
+	}
+	for _, slot := range slots {
+		minutes, _ := f.slotMinutes(slot.Time)
+		response.TotalPrice += prices[slot.UUID] * minutes / 60
+		response.Slots = append(response.Slots, dto.BookedFieldScheduleSlots{
+			UUID:         slot.UUID,
+			Time:         fmt.Sprintf("%s - %s", slot.Time.StartTime, slot.Time.EndTime),
+			PricePerHour: prices[slot.UUID],
+		})
+	}
+
+	fmt.Printf("🏁 [INFO-FIELD-SCHEDULE-SERVICE] BookRange selesai: %+v\n", response)
+	return response, nil
+}
+
+// resolveContiguousSlots mengambil slot (urut berdasarkan jam mulai) yang dimulai tepat di startTime
+// dan bersambung (EndTime slot sebelumnya = StartTime slot berikutnya) sampai durasinya pas durationMinutes.
+func (f *FieldScheduleService) resolveContiguousSlots(
+	fieldSchedules []models.FieldSchedule,
+	startTime string,
+	durationMinutes int,
+) ([]models.FieldSchedule, error) {
+	slots := make([]models.FieldSchedule, 0)
+	total := 0
+	next := startTime
+	for _, schedule := range fieldSchedules {
+		if total >= durationMinutes {
+			break
+		}
+
+		if schedule.Time.StartTime != next {
+			if len(slots) > 0 {
+				// slot berikutnya tidak nyambung, range terputus
+				break
+			}
+			continue
+		}
+
+		minutes, err := f.slotMinutes(schedule.Time)
+		if err != nil {
+			return nil, err
+		}
+
+		slots = append(slots, schedule)
+		total += minutes
+		next = schedule.Time.EndTime
+	}
+
+	if len(slots) == 0 || total != durationMinutes {
+		return nil, errFieldSchedule.ErrFieldScheduleRangeNotAvailable
+	}
+	return slots, nil
+}
+
+// slotMinutes menghitung durasi satu time slot dalam menit. Jam selesai 00:00 dibaca sebagai
+// tengah malam (24:00), aturan yang sama dengan validasi time slot di time service.
+func (f *FieldScheduleService) slotMinutes(slot models.Time) (int, error) {
+	start, err := time.Parse(time.TimeOnly, slot.StartTime)
+	if err != nil {
+		return 0, errFieldSchedule.ErrInvalidTimeRange
+	}
+
+	end, err := time.Parse(time.TimeOnly, slot.EndTime)
+	if err != nil {
+		return 0, errFieldSchedule.ErrInvalidTimeRange
+	}
+
+	if slot.EndTime == constants.MidnightTime {
+		end = end.Add(24 * time.Hour)
+	}
+
+	if !end.After(start) {
+		return 0, errFieldSchedule.ErrInvalidTimeRange
+	}
+	return int(end.Sub(start).Minutes()), nil
+}
+
+// parseClock menormalkan "HH:MM" atau "HH:MM:SS" jadi "HH:MM:SS" (format kolom time di Postgres).
+func (f *FieldScheduleService) parseClock(value string) (string, error) {
+	for _, layout := range []string{time.TimeOnly, "15:04"} {
+		parsed, err := time.Parse(layout, value)
+		if err == nil {
+			return parsed.Format(time.TimeOnly), nil
+		}
+	}
+	return "", errFieldSchedule.ErrInvalidTimeRange
+}
+
+// validateStatusTransition adalah satu-satunya pengecekan transisi status jadwal.
+// Semua write path yang mengubah status wajib lewat sini (langsung atau via transitionValidator).
+func (f *FieldScheduleService) validateStatusTransition(from, to constants.FieldScheduleStatus) error {
+	if !from.CanTransitionTo(to) {
+		return &errFieldSchedule.InvalidTransitionError{
+			From: string(from.GetStatusString()),
+			To:   string(to.GetStatusString()),
+		}
+	}
+	return nil
+}
+
+// transitionValidator membuat validator repository untuk perpindahan ke status to.
+// checks dijalankan lebih dulu supaya error khusus operasi (misal sudah Booked) lebih jelas.
+func (f *FieldScheduleService) transitionValidator(
+	to constants.FieldScheduleStatus,
+	checks ...fieldScheduleRepositories.FieldScheduleValidator,
+) fieldScheduleRepositories.FieldScheduleValidator {
+	return func(item models.FieldSchedule) error {
+		for _, check := range checks {
+			err := check(item)
+			if err != nil {
+				return err
+			}
+		}
+		return f.validateStatusTransition(item.Status, to)
+	}
+}
+
+// holdGuard menolak slot Held milik customer lain yang hold-nya belum expired.
+// Dipakai Hold dan booking supaya hold benar-benar mencegah dua checkout untuk slot yang sama.
+func (f *FieldScheduleService) holdGuard(
+	userID uuid.UUID,
+	now time.Time,
+) fieldScheduleRepositories.FieldScheduleValidator {
+	return func(item models.FieldSchedule) error {
+		if item.Status != constants.Held {
+			return nil
+		}
+
+		isOwner := item.HeldBy != nil && *item.HeldBy == userID
+		isExpired := item.HeldUntil != nil && !item.HeldUntil.After(now)
+		if !isOwner && !isExpired {
+			return errFieldSchedule.ErrFieldScheduleIsHeld
+		}
+		return nil
+	}
+}
+
+// uniqueFieldScheduleIDs membuang UUID yang dobel dengan tetap menjaga urutan aslinya.
+func (f *FieldScheduleService) uniqueFieldScheduleIDs(fieldScheduleIDs []string) []string {
+	result := make([]string, 0, len(fieldScheduleIDs))
+	seen := make(map[string]bool, len(fieldScheduleIDs))
+	for _, item := range fieldScheduleIDs {
+		if seen[item] {
+			continue
+		}
+		seen[item] = true
+		result = append(result, item)
+	}
+	return result
+}
+
+func (f *FieldScheduleService) Hold(
+	ctx context.Context,
+	request *dto.HoldFieldScheduleRequest,
+) (*dto.HoldFieldScheduleResponse, error) {
+	// 🚀 [DEBUG-FIELD-SCHEDULE-SERVICE] Mulai function Hold
+	fmt.Println("🚀 [DEBUG-FIELD-SCHEDULE-SERVICE] Start Hold")
+	fmt.Printf("📥 [DEBUG-FIELD-SCHEDULE-SERVICE] Input request: %+v\n", request)
+
+	// 1️⃣ Parse UUID customer pemilik hold
+	userID, err := uuid.Parse(request.UserID)
+	if err != nil {
+		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] UserID tidak valid:", err)
+		return nil, err
+	}
+
+	// 2️⃣ Hitung batas waktu hold dari config (default 10 menit)
+	holdDuration := config.Config.HoldDurationMinutes
+	if holdDuration <= 0 {
+		holdDuration = defaultHoldDurationMinutes
+	}
+	heldUntil := time.Now().Add(time.Duration(holdDuration) * time.Minute)
+
+	// 3️⃣ Hold semua slot dalam satu transaksi
+	fieldScheduleIDs := f.uniqueFieldScheduleIDs(request.FieldScheduleIDs)
+	now := time.Now()
+	err = f.repository.GetFieldSchedule().HoldInBatch(
+		ctx,
+		fieldScheduleIDs,
+		userID,
+		heldUntil,
+		f.transitionValidator(
+			constants.Held,
+			func(item models.FieldSchedule) error {
+				if item.Status == constants.Booked {
+					return errFieldSchedule.ErrFieldScheduleIsBooked
+				}
+				return nil
+			},
+			f.holdGuard(userID, now),
+		),
+	)
+	if err != nil {
+		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal hold schedule:", err)
+		return nil, err
+	}
+
+	fmt.Println("🏁 [DEBUG-FIELD-SCHEDULE-SERVICE] End Hold sukses, heldUntil:", heldUntil)
+	return &dto.HoldFieldScheduleResponse{
+		FieldScheduleIDs: fieldScheduleIDs,
+		HeldUntil:        heldUntil,
+	}, nil
+}
+
+func (f *FieldScheduleService) ReleaseHold(ctx context.Context, request *dto.ReleaseHoldFieldScheduleRequest) error {
+	// 🚀 [DEBUG-FIELD-SCHEDULE-SERVICE] Mulai function ReleaseHold
+	fmt.Println("🚀 [DEBUG-FIELD-SCHEDULE-SERVICE] Start ReleaseHold")
+	fmt.Printf("📥 [DEBUG-FIELD-SCHEDULE-SERVICE] Input request: %+v\n", request)
+
+	userID, err := uuid.Parse(request.UserID)
+	if err != nil {
+		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] UserID tidak valid:", err)
+		return err
+	}
+
+	fieldScheduleIDs := f.uniqueFieldScheduleIDs(request.FieldScheduleIDs)
+	err = f.repository.GetFieldSchedule().UpdateStatusInBatch(
+		ctx,
+		constants.Available,
+		fieldScheduleIDs,
+		f.transitionValidator(constants.Available, func(item models.FieldSchedule) error {
+			if item.Status != constants.Held || item.HeldBy == nil || *item.HeldBy != userID {
+				return errFieldSchedule.ErrFieldScheduleNotHeld
+			}
+			return nil
+		}),
+	)
+	if err != nil {
+		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal release hold schedule:", err)
+		return err
+	}
+
+	fmt.Println("🏁 [DEBUG-FIELD-SCHEDULE-SERVICE] End ReleaseHold sukses")
+	return nil
+}
+
+// ReleaseExpiredHolds dipanggil oleh hold sweeper untuk mengembalikan hold yang expired ke Available.
+func (f *FieldScheduleService) ReleaseExpiredHolds(ctx context.Context) (int64, error) {
+	total, err := f.repository.GetFieldSchedule().ReleaseExpiredHolds(ctx, time.Now())
+	if err != nil {
+		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal release hold yang expired:", err)
+		return 0, err
+	}
+
+	if total > 0 {
+		fmt.Printf("✅ [INFO-FIELD-SCHEDULE-SERVICE] %d hold expired dikembalikan ke Available\n", total)
+	}
+	return total, nil
+}
+
+func (f *FieldScheduleService) Release(ctx context.Context, request *dto.ReleaseFieldScheduleRequest) error {
+	// 🚀 [DEBUG-FIELD-SCHEDULE-SERVICE] Mulai function Release
+	fmt.Println("🚀 [DEBUG-FIELD-SCHEDULE-SERVICE] Start Release")
+	fmt.Printf("📥 [DEBUG-FIELD-SCHEDULE-SERVICE] Input request: %+v\n", request)
+
+	// 1️⃣ Tentukan status tujuan, default Available
+	status := constants.Available
+	if request.Status != "" {
+		status = request.Status.GetStatusInt()
+	}
+
+	// 2️⃣ Lepas semua slot Booked dalam satu transaksi + catat alasan dan pelakunya
+	// 📝 Catatan:
+	// Hanya slot berstatus Booked yang boleh dilepas, selain itu seluruh batch ditolak.
+	// Semua perpindahan Booked -> non-Booked lewat sini supaya selalu tercatat di field_schedule_releases.
+	fieldScheduleIDs := f.uniqueFieldScheduleIDs(request.FieldScheduleIDs)
+	err := f.repository.GetFieldSchedule().ReleaseInBatch(
+		ctx,
+		status,
+		fieldScheduleIDs,
+		request.Reason,
+		request.ReleasedBy,
+		f.transitionValidator(status, func(item models.FieldSchedule) error {
+			if item.Status != constants.Booked {
+				return errFieldSchedule.ErrFieldScheduleNotBooked
+			}
+			return nil
+		}),
+	)
+	if err != nil {
+		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal release schedule:", err)
+		return err
+	}
+
+	fmt.Println("🏁 [DEBUG-FIELD-SCHEDULE-SERVICE] End Release sukses")
+	return nil
+}
+
+func (f *FieldScheduleService) ChangeStatus(ctx context.Context, request *dto.ChangeStatusFieldScheduleRequest) error {
+	// 🚀 [DEBUG-FIELD-SCHEDULE-SERVICE] Mulai function ChangeStatus
+	fmt.Println("🚀 [DEBUG-FIELD-SCHEDULE-SERVICE] Start ChangeStatus")
+	fmt.Printf("📥 [DEBUG-FIELD-SCHEDULE-SERVICE] Input request: %+v\n", request)
+
+	// 1️⃣ Ubah status jadwal oleh admin (Blocked, Maintenance, Cancelled, Available)
+	// 📝 Catatan:
+	// Slot Booked tidak boleh diubah statusnya dari sini (ke status apa pun),
+	// harus lewat Release supaya alasan dan pelakunya tercatat dan snapshot harga dibersihkan.
+	status := request.Status.GetStatusInt()
+	fieldScheduleIDs := f.uniqueFieldScheduleIDs(request.FieldScheduleIDs)
+	err := f.repository.GetFieldSchedule().UpdateStatusInBatch(
+		ctx,
+		status,
+		fieldScheduleIDs,
+		f.transitionValidator(status, func(item models.FieldSchedule) error {
+			if item.Status == constants.Booked {
+				return errFieldSchedule.ErrFieldScheduleIsBooked
+			}
+			return nil
+		}),
+	)
+	if err != nil {
+		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal ubah status schedule:", err)
+		return err
+	}
+
+	fmt.Println("🏁 [DEBUG-FIELD-SCHEDULE-SERVICE] End ChangeStatus sukses")
+	return nil
+}
+
+func (f *FieldScheduleService) Delete(
+	ctx context.Context,
+	uuid string,
+	request *dto.DeleteFieldScheduleRequest,
+) error {
+	// 🚀 [DEBUG-FIELD-SCHEDULE-SERVICE] Mulai function Delete
+	fmt.Println("🚀 [DEBUG-FIELD-SCHEDULE-SERVICE] Start Delete")
+	fmt.Printf("📥 [DEBUG-FIELD-SCHEDULE-SERVICE] UUID: %s, force: %t\n", uuid, request.Force)
+
+	// 1️⃣ Hapus fieldSchedule berdasarkan UUID
+	err := f.repository.GetFieldSchedule().Delete(
+		ctx,
+		uuid,
+		f.bookedGuard(request.Force),
+		f.bookingEvent(constants.FieldScheduleBookingVoided, request.Reason, request.ChangedBy, nil, nil, nil),
+	)
 	if err != nil {
 		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal hapus fieldSchedule:", err)
 		return err
 	}
 	fmt.Printf("✅ [INFO-FIELD-SCHEDULE-SERVICE] FieldSchedule berhasil dihapus: %s\n", uuid)
+	// 📝 Catatan:
+	// Pengecekan "jadwal ada" dan "sudah Booked" dilakukan repository di dalam transaksi
+	// setelah baris dikunci, supaya tidak bentrok dengan booking yang masuk bersamaan.
 
-	// 3️⃣ Selesai, return success
+	// 2️⃣ Selesai, return success
 	fmt.Println("🏁 [DEBUG-FIELD-SCHEDULE-SERVICE] End Delete sukses")
 	return nil
 }
+
+// bookedGuard menolak perubahan pada jadwal Booked kecuali force=true.
+func (f *FieldScheduleService) bookedGuard(force bool) fieldScheduleRepositories.FieldScheduleValidator {
+	return func(item models.FieldSchedule) error {
+		if item.Status == constants.Booked && !force {
+			return errFieldSchedule.ErrFieldScheduleIsBooked
+		}
+		return nil
+	}
+}
+
+// bookingEvent membuat event builder untuk perubahan paksa; hanya jadwal Booked yang menghasilkan event.
+// newFieldID/newDate/newTime nil artinya booking dibatalkan (bukan dipindah).
+func (f *FieldScheduleService) bookingEvent(
+	eventType constants.FieldScheduleEventType,
+	reason string,
+	changedBy string,
+	newFieldID *uuid.UUID,
+	newDate *time.Time,
+	newTime *string,
+) fieldScheduleRepositories.FieldScheduleEventBuilder {
+	return func(item models.FieldSchedule) *models.FieldScheduleEvent {
+		if item.Status != constants.Booked {
+			return nil
+		}
+
+		return &models.FieldScheduleEvent{
+			UUID:              uuid.New(),
+			EventType:         eventType,
+			FieldScheduleUUID: item.UUID,
+			FieldUUID:         item.Field.UUID,
+			NewFieldUUID:      newFieldID,
+			PreviousDate:      item.Date,
+			PreviousTime:      fmt.Sprintf("%s - %s", item.Time.StartTime, item.Time.EndTime),
+			NewDate:           newDate,
+			NewTime:           newTime,
+			PricePerHour:      item.PricePerHour,
+			Reason:            reason,
+			ChangedBy:         changedBy,
+		}
+	}
+}
+
+func (f *FieldScheduleService) GetEvents(
+	ctx context.Context,
+	param *dto.FieldScheduleEventRequestParam,
+) ([]dto.FieldScheduleEventResponse, error) {
+	// 🚀 [DEBUG-FIELD-SCHEDULE-SERVICE] Mulai function GetEvents
+	fmt.Println("🚀 [DEBUG-FIELD-SCHEDULE-SERVICE] Start GetEvents")
+	fmt.Printf("📥 [DEBUG-FIELD-SCHEDULE-SERVICE] Param: %+v\n", param)
+
+	// 1️⃣ Tentukan limit default
+	limit := param.Limit
+	if limit == 0 {
+		limit = defaultEventLimit
+	}
+
+	// 2️⃣ Ambil event setelah afterID
+	events, err := f.repository.GetFieldScheduleEvent().FindAfterID(ctx, param.AfterID, limit)
+	if err != nil {
+		fmt.Println("❌ [ERROR-FIELD-SCHEDULE-SERVICE] Gagal ambil field schedule event:", err)
+		return nil, err
+	}
+
+	// 3️⃣ Susun response
+	results := make([]dto.FieldScheduleEventResponse, 0, len(events))
+	for _, event := range events {
+		var newDate *string
+		if event.NewDate != nil {
+			formatted := event.NewDate.Format(time.DateOnly)
+			newDate = &formatted
+		}
+
+		results = append(results, dto.FieldScheduleEventResponse{
+			ID:              event.ID,
+			UUID:            event.UUID,
+			EventType:       event.EventType,
+			FieldScheduleID: event.FieldScheduleUUID,
+			FieldID:         event.FieldUUID,
+			NewFieldID:      event.NewFieldUUID,
+			PreviousDate:    event.PreviousDate.Format(time.DateOnly),
+			PreviousTime:    event.PreviousTime,
+			NewDate:         newDate,
+			NewTime:         event.NewTime,
+			PricePerHour:    event.PricePerHour,
+			Reason:          event.Reason,
+			ChangedBy:       event.ChangedBy,
+			CreatedAt:       event.CreatedAt,
+		})
+	}
+
+	fmt.Printf("✅ [INFO-FIELD-SCHEDULE-SERVICE] Total event: %d\n", len(results))
+	fmt.Println("🏁 [DEBUG-FIELD-SCHEDULE-SERVICE] End GetEvents sukses")
+	return results, nil
+}
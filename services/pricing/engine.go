@@ -0,0 +1,69 @@
+package services
+
+import (
+	"field-service/constants"
+	"field-service/domain/models"
+	"slices"
+	"time"
+)
+
+// PricingEngine menghitung harga efektif per slot dari harga dasar field dan daftar pricing rule.
+// Rule dievaluasi berurutan (sesuai urutan dari repository: priority kecil dulu), jadi rule
+// Override dengan priority kecil bisa tetap dikenai surcharge dari rule dengan priority lebih besar.
+type PricingEngine struct {
+	rules []models.PricingRule
+}
+
+func NewPricingEngine(rules []models.PricingRule) *PricingEngine {
+	return &PricingEngine{rules: rules}
+}
+
+// EffectivePrice mengembalikan harga per jam untuk slot di tanggal date yang mulai jam startTime (HH:MM:SS).
+func (p *PricingEngine) EffectivePrice(basePrice int, date time.Time, startTime string) int {
+	price := basePrice
+	for _, rule := range p.rules {
+		if !p.matches(&rule, date, startTime) {
+			continue
+		}
+
+		switch rule.AdjustmentType {
+		case constants.PricingOverride:
+			price = rule.Value
+		case constants.PricingPercentage:
+			price += price * rule.Value / 100
+		case constants.PricingFixed:
+			price += rule.Value
+		}
+	}
+
+	if price < 0 {
+		return 0
+	}
+	return price
+}
+
+func (p *PricingEngine) matches(rule *models.PricingRule, date time.Time, startTime string) bool {
+	if !rule.IsActive {
+		return false
+	}
+
+	if rule.Date != nil && rule.Date.Format(time.DateOnly) != date.Format(time.DateOnly) {
+		return false
+	}
+
+	if len(rule.Weekdays) > 0 && !slices.Contains(rule.Weekdays, int64(date.Weekday())) {
+		return false
+	}
+
+	// 📝 Catatan:
+	// Slot kena rule kalau jam mulainya ada di [StartTime, EndTime).
+	if rule.StartTime != nil && startTime < *rule.StartTime {
+		return false
+	}
+
+	if rule.EndTime != nil && startTime >= *rule.EndTime {
+		return false
+	}
+
+	return true
+}
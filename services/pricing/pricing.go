@@ -0,0 +1,217 @@
+package services
+
+import (
+	"context"
+	errPricingRule "field-service/constants/error/pricingrule"
+	"field-service/domain/dto"
+	"field-service/domain/models"
+	"field-service/repositories"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type PricingRuleService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+type IPricingRuleService interface {
+	GetAll(context.Context) ([]dto.PricingRuleResponse, error)
+	GetByUUID(context.Context, string) (*dto.PricingRuleResponse, error)
+	Create(context.Context, *dto.PricingRuleRequest) (*dto.PricingRuleResponse, error)
+	Update(context.Context, string, *dto.PricingRuleRequest) (*dto.PricingRuleResponse, error)
+	Delete(context.Context, string) error
+}
+
+func NewPricingRuleService(repository repositories.IRepositoryRegistry) IPricingRuleService {
+	return &PricingRuleService{repository: repository}
+}
+
+func (p *PricingRuleService) toResponse(rule *models.PricingRule) dto.PricingRuleResponse {
+	response := dto.PricingRuleResponse{
+		UUID:           rule.UUID,
+		Name:           rule.Name,
+		Weekdays:       rule.Weekdays,
+		StartTime:      rule.StartTime,
+		EndTime:        rule.EndTime,
+		AdjustmentType: rule.AdjustmentType,
+		Value:          rule.Value,
+		Priority:       rule.Priority,
+		IsActive:       rule.IsActive,
+		CreatedAt:      rule.CreatedAt,
+		UpdatedAt:      rule.UpdatedAt,
+	}
+
+	if rule.Field != nil {
+		fieldID := rule.Field.UUID
+		response.FieldID = &fieldID
+		response.FieldName = rule.Field.Name
+	}
+
+	if rule.Date != nil {
+		date := rule.Date.Format(time.DateOnly)
+		response.Date = &date
+	}
+
+	return response
+}
+
+// toModel memvalidasi request lalu mengubahnya ke models.PricingRule.
+func (p *PricingRuleService) toModel(ctx context.Context, request *dto.PricingRuleRequest) (*models.PricingRule, error) {
+	rule := &models.PricingRule{
+		Name:           request.Name,
+		Weekdays:       pq.Int64Array{},
+		AdjustmentType: request.AdjustmentType,
+		Value:          request.Value,
+		Priority:       request.Priority,
+		IsActive:       true,
+	}
+
+	if request.IsActive != nil {
+		rule.IsActive = *request.IsActive
+	}
+
+	// ✅ Field opsional: kosong = berlaku untuk semua field
+	if request.FieldID != "" {
+		field, err := p.repository.GetField().FindByUUID(ctx, request.FieldID)
+		if err != nil {
+			return nil, err
+		}
+		rule.FieldID = &field.ID
+	}
+
+	for _, weekday := range request.Weekdays {
+		rule.Weekdays = append(rule.Weekdays, int64(weekday))
+	}
+
+	// ✅ Jam opsional, tapi kalau diisi harus valid dan start < end
+	startTime, err := p.parseClock(request.StartTime)
+	if err != nil {
+		return nil, errPricingRule.ErrPricingRuleInvalidTimeRange
+	}
+
+	endTime, err := p.parseClock(request.EndTime)
+	if err != nil {
+		return nil, errPricingRule.ErrPricingRuleInvalidTimeRange
+	}
+
+	if startTime != nil && endTime != nil && *startTime >= *endTime {
+		return nil, errPricingRule.ErrPricingRuleInvalidTimeRange
+	}
+	rule.StartTime = startTime
+	rule.EndTime = endTime
+
+	// ✅ Tanggal opsional untuk override tanggal tertentu
+	if request.Date != "" {
+		date, err := time.Parse(time.DateOnly, request.Date)
+		if err != nil {
+			return nil, errPricingRule.ErrPricingRuleInvalidDate
+		}
+		rule.Date = &date
+	}
+
+	return rule, nil
+}
+
+// parseClock menormalkan "HH:MM" atau "HH:MM:SS" jadi "HH:MM:SS" (format kolom time di Postgres).
+func (p *PricingRuleService) parseClock(value string) (*string, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	for _, layout := range []string{time.TimeOnly, "15:04"} {
+		parsed, err := time.Parse(layout, value)
+		if err == nil {
+			clock := parsed.Format(time.TimeOnly)
+			return &clock, nil
+		}
+	}
+
+	return nil, errPricingRule.ErrPricingRuleInvalidTimeRange
+}
+
+func (p *PricingRuleService) GetAll(ctx context.Context) ([]dto.PricingRuleResponse, error) {
+	fmt.Println("🚀 [DEBUG-PRICING-RULE-SERVICE] Mulai GetAll")
+	rules, err := p.repository.GetPricingRule().FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ruleResults := make([]dto.PricingRuleResponse, 0, len(rules))
+	for _, rule := range rules {
+		ruleResults = append(ruleResults, p.toResponse(&rule))
+	}
+
+	fmt.Println("🏁 [INFO-PRICING-RULE-SERVICE] GetAll selesai:", len(ruleResults))
+	return ruleResults, nil
+}
+
+func (p *PricingRuleService) GetByUUID(ctx context.Context, uuid string) (*dto.PricingRuleResponse, error) {
+	fmt.Println("🔍 [DEBUG-PRICING-RULE-SERVICE] GetByUUID:", uuid)
+	rule, err := p.repository.GetPricingRule().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	response := p.toResponse(rule)
+	return &response, nil
+}
+
+func (p *PricingRuleService) Create(
+	ctx context.Context,
+	request *dto.PricingRuleRequest,
+) (*dto.PricingRuleResponse, error) {
+	fmt.Printf("🚀 [DEBUG-PRICING-RULE-SERVICE] Create: %+v\n", request)
+
+	rule, err := p.toModel(ctx, request)
+	if err != nil {
+		fmt.Println("❌ [ERROR-PRICING-RULE-SERVICE] Request tidak valid:", err)
+		return nil, err
+	}
+
+	rule, err = p.repository.GetPricingRule().Create(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	// 🔄 Ambil ulang supaya relasi Field ikut ter-load untuk response
+	return p.GetByUUID(ctx, rule.UUID.String())
+}
+
+func (p *PricingRuleService) Update(
+	ctx context.Context,
+	uuid string,
+	request *dto.PricingRuleRequest,
+) (*dto.PricingRuleResponse, error) {
+	fmt.Printf("🚀 [DEBUG-PRICING-RULE-SERVICE] Update %s: %+v\n", uuid, request)
+
+	_, err := p.repository.GetPricingRule().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := p.toModel(ctx, request)
+	if err != nil {
+		fmt.Println("❌ [ERROR-PRICING-RULE-SERVICE] Request tidak valid:", err)
+		return nil, err
+	}
+
+	rule, err = p.repository.GetPricingRule().Update(ctx, uuid, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	response := p.toResponse(rule)
+	return &response, nil
+}
+
+func (p *PricingRuleService) Delete(ctx context.Context, uuid string) error {
+	fmt.Println("🚀 [DEBUG-PRICING-RULE-SERVICE] Delete:", uuid)
+	_, err := p.repository.GetPricingRule().FindByUUID(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	return p.repository.GetPricingRule().Delete(ctx, uuid)
+}
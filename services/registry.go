@@ -3,9 +3,12 @@ package services
 import (
 	"field-service/common/gcs"
 	"field-service/repositories"
+	closureService "field-service/services/closure"
 	fieldService "field-service/services/field"
 	fieldScheduleService "field-service/services/fieldschedule"
+	pricingService "field-service/services/pricing"
 	timeService "field-service/services/time"
+	venueService "field-service/services/venue"
 	"fmt"
 )
 
@@ -18,6 +21,9 @@ type IServiceRegistry interface {
 	GetField() fieldService.IFieldService
 	GetFieldSchedule() fieldScheduleService.IFieldScheduleService
 	GetTime() timeService.ITimeService
+	GetClosure() closureService.IClosureService
+	GetPricingRule() pricingService.IPricingRuleService
+	GetVenue() venueService.IVenueService
 }
 
 func NewServiceRegistry(repository repositories.IRepositoryRegistry, gcs gcs.IGCSClient) IServiceRegistry {
@@ -44,3 +50,15 @@ func (r *Registry) GetFieldSchedule() fieldScheduleService.IFieldScheduleService
 func (r *Registry) GetTime() timeService.ITimeService {
 	return timeService.NewTimeService(r.repository)
 }
+
+func (r *Registry) GetClosure() closureService.IClosureService {
+	return closureService.NewClosureService(r.repository)
+}
+
+func (r *Registry) GetPricingRule() pricingService.IPricingRuleService {
+	return pricingService.NewPricingRuleService(r.repository)
+}
+
+func (r *Registry) GetVenue() venueService.IVenueService {
+	return venueService.NewVenueService(r.repository)
+}
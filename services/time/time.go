@@ -2,10 +2,16 @@ package services
 
 import (
 	"context"
+	"field-service/constants"
+	errTime "field-service/constants/error/time"
 	"field-service/domain/dto"
 	"field-service/domain/models"
 	"field-service/repositories"
 	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 type TimeService struct {
@@ -13,19 +19,35 @@ type TimeService struct {
 }
 
 type ITimeService interface {
-	GetAll(context.Context) ([]dto.TimeResponse, error)
+	GetAll(context.Context, string) ([]dto.TimeResponse, error)
 	GetByUUID(context.Context, string) (*dto.TimeResponse, error)
 	Create(context.Context, *dto.TimeRequest) (*dto.TimeResponse, error)
+	Update(context.Context, string, *dto.TimeRequest) (*dto.TimeResponse, error)
+	Delete(context.Context, string) error
 }
 
 func NewTimeService(repository repositories.IRepositoryRegistry) ITimeService {
 	return &TimeService{repository: repository}
 }
 
-func (t *TimeService) GetAll(ctx context.Context) ([]dto.TimeResponse, error) {
+// GetAll mengambil semua time slot. Kalau fieldID diisi, yang dikembalikan adalah katalog
+// waktu field tersebut (slot milik field, atau katalog global kalau field tidak punya).
+func (t *TimeService) GetAll(ctx context.Context, fieldID string) ([]dto.TimeResponse, error) {
 	// 🚀 Step 1: Ambil semua data time dari repository
-	fmt.Println("🚀 [DEBUG-TIME-SERVICE] Mulai GetAll")
-	times, err := t.repository.GetTime().FindAll(ctx)
+	fmt.Println("🚀 [DEBUG-TIME-SERVICE] Mulai GetAll, fieldID:", fieldID)
+	var (
+		times []models.Time
+		err   error
+	)
+	if fieldID != "" {
+		field, fieldErr := t.repository.GetField().FindByUUID(ctx, fieldID)
+		if fieldErr != nil {
+			return nil, fieldErr
+		}
+		times, err = t.repository.GetTime().FindCatalogByFieldID(ctx, field.ID)
+	} else {
+		times, err = t.repository.GetTime().FindAll(ctx)
+	}
 	if err != nil {
 		fmt.Println("❌ [ERROR-TIME-SERVICE] Gagal mengambil data waktu:", err)
 		return nil, err
@@ -36,17 +58,11 @@ func (t *TimeService) GetAll(ctx context.Context) ([]dto.TimeResponse, error) {
 	timeResults := make([]dto.TimeResponse, 0, len(times))
 
 	// 🔄 Step 3: Loop tiap data untuk diubah ke format DTO dan tambahkan ke response
-	for _, time := range times {
-		fmt.Printf("🔍 [DEBUG-TIME-SERVICE] Proses time: %+v\n", time)
+	for _, item := range times {
+		fmt.Printf("🔍 [DEBUG-TIME-SERVICE] Proses time: %+v\n", item)
 		// Ubah data time ke format DTO
 		// dan tambahkan ke slice hasil response
-		timeResults = append(timeResults, dto.TimeResponse{
-			UUID:      time.UUID,
-			StartTime: time.StartTime,
-			EndTime:   time.EndTime,
-			CreatedAt: time.CreatedAt,
-			UpdatedAt: time.UpdatedAt,
-		})
+		timeResults = append(timeResults, t.toResponse(&item))
 	}
 
 	// ✅ Step 4: Return hasil final
@@ -60,23 +76,17 @@ func (t *TimeService) GetByUUID(ctx context.Context, uuid string) (*dto.TimeResp
 	fmt.Println("🆔 [DEBUG-TIME-SERVICE] UUID:", uuid)
 
 	// 🔎 Step 2: Ambil data dari repository berdasarkan UUID
-	time, err := t.repository.GetTime().FindByUUID(ctx, uuid)
+	timeData, err := t.repository.GetTime().FindByUUID(ctx, uuid)
 	if err != nil {
 		fmt.Printf("❌ [ERROR-TIME-SERVICE] Gagal mengambil data waktu dengan UUID %s: %v\n", uuid, err)
 		return nil, err
 	}
-	fmt.Printf("✅ [INFO-TIME-SERVICE] Berhasil mengambil data waktu dengan UUID %s: %+v\n", uuid, time)
+	fmt.Printf("✅ [INFO-TIME-SERVICE] Berhasil mengambil data waktu dengan UUID %s: %+v\n", uuid, timeData)
 
 	// 🛠️ Step 3: Siapkan hasil response
 	// Ubah data time ke format DTO
 	// dan kembalikan hasil response
-	timeResult := dto.TimeResponse{
-		UUID:      time.UUID,
-		StartTime: time.StartTime,
-		EndTime:   time.EndTime,
-		CreatedAt: time.CreatedAt,
-		UpdatedAt: time.UpdatedAt,
-	}
+	timeResult := t.toResponse(timeData)
 	fmt.Printf("📦 [DEBUG-TIME-SERVICE] Hasil DTO: %+v\n", timeResult)
 
 	// ✅ Step 4: Return hasil DTO
@@ -89,36 +99,230 @@ func (t *TimeService) Create(ctx context.Context, req *dto.TimeRequest) (*dto.Ti
 	fmt.Println("🚀 [DEBUG-TIME-SERVICE] Create dimulai")
 	fmt.Printf("📥 [DEBUG-TIME-SERVICE] Input: %+v\n", req)
 
-	// 🛠️ Step 2: Persiapkan data untuk disimpan (ambil dari request)
-	time := &dto.TimeRequest{
-		StartTime: req.StartTime,
-		EndTime:   req.EndTime,
+	// 🛠️ Step 2: Tentukan katalognya (field tertentu atau global)
+	var field *models.Field
+	if req.FieldID != "" {
+		var err error
+		field, err = t.repository.GetField().FindByUUID(ctx, req.FieldID)
+		if err != nil {
+			fmt.Printf("❌ [ERROR-TIME-SERVICE] Field tidak ditemukan: %v\n", err)
+			return nil, err
+		}
+	}
+
+	var fieldID *uint
+	if field != nil {
+		fieldID = &field.ID
+	}
+
+	// 🛠️ Step 3: Validasi format, durasi dan overlap dengan slot lain di katalog yang sama
+	startTime, endTime, err := t.validateTimeRange(ctx, fieldID, req, "")
+	if err != nil {
+		fmt.Printf("❌ [ERROR-TIME-SERVICE] Data waktu tidak valid: %v\n", err)
+		return nil, err
 	}
 
-	// 💾 Step 3: Simpan ke database melalui repository
+	// 💾 Step 4: Simpan ke database melalui repository
 	timeResult, err := t.repository.GetTime().Create(ctx, &models.Time{
-		StartTime: time.StartTime,
-		EndTime:   time.EndTime,
+		FieldID:   fieldID,
+		StartTime: startTime,
+		EndTime:   endTime,
 	})
 	if err != nil {
 		fmt.Printf("❌ [ERROR-TIME-SERVICE] Gagal menyimpan data waktu: %v\n", err)
 		return nil, err
 	}
 	fmt.Printf("✅ [INFO-TIME-SERVICE] Berhasil menyimpan data waktu: %+v\n", timeResult)
+	timeResult.Field = field
 
-	// 🛠️ Step 4: Siapkan hasil response
+	// 🛠️ Step 5: Siapkan hasil response
 	// Ubah hasil DB ke format DTO
 	// dan kembalikan hasil response
-	response := dto.TimeResponse{
-		UUID:      timeResult.UUID,
-		StartTime: timeResult.StartTime,
-		EndTime:   timeResult.EndTime,
-		CreatedAt: timeResult.CreatedAt,
-		UpdatedAt: timeResult.UpdatedAt,
-	}
+	response := t.toResponse(timeResult)
 	fmt.Printf("📤 [DEBUG-TIME-SERVICE] Response: %+v\n", response)
 
-	// ✅ Step 5: Return hasil response
+	// ✅ Step 6: Return hasil response
 	fmt.Println("🏁 [INFO-TIME-SERVICE] Selesai Create, return response")
 	return &response, nil
 }
+
+func (t *TimeService) Update(ctx context.Context, uuid string, req *dto.TimeRequest) (*dto.TimeResponse, error) {
+	// 🚀 Step 1: Mulai proses & debug input
+	fmt.Println("🚀 [DEBUG-TIME-SERVICE] Update dimulai:", uuid)
+	fmt.Printf("📥 [DEBUG-TIME-SERVICE] Input: %+v\n", req)
+
+	// 🔎 Step 2: Pastikan time slot ada dan belum pernah dipakai jadwal
+	// 📝 Catatan:
+	// Jam mulai/selesai ditampilkan di semua jadwal yang memakai slot ini, termasuk booking lama.
+	// Slot yang sudah dipakai tidak diubah; buat slot baru supaya histori tetap menampilkan jam aslinya.
+	timeData, err := t.repository.GetTime().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.ensureNotUsed(ctx, timeData)
+	if err != nil {
+		return nil, err
+	}
+
+	// 🛠️ Step 3: Validasi format, durasi dan overlap (slot ini sendiri dikecualikan)
+	// 📝 Catatan:
+	// Katalog slot (global/field) tidak dipindah lewat update, jadi overlap dicek di katalog asalnya.
+	startTime, endTime, err := t.validateTimeRange(ctx, timeData.FieldID, req, uuid)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-TIME-SERVICE] Data waktu tidak valid: %v\n", err)
+		return nil, err
+	}
+
+	// 💾 Step 4: Simpan perubahan
+	timeResult, err := t.repository.GetTime().Update(ctx, uuid, &models.Time{
+		StartTime: startTime,
+		EndTime:   endTime,
+	})
+	if err != nil {
+		fmt.Printf("❌ [ERROR-TIME-SERVICE] Gagal memperbarui data waktu: %v\n", err)
+		return nil, err
+	}
+
+	// ✅ Step 5: Return hasil response
+	response := t.toResponse(timeResult)
+	fmt.Println("🏁 [INFO-TIME-SERVICE] Selesai Update, return response")
+	return &response, nil
+}
+
+func (t *TimeService) Delete(ctx context.Context, uuid string) error {
+	// 🚀 Step 1: Mulai proses & debug input
+	fmt.Println("🚀 [DEBUG-TIME-SERVICE] Delete dimulai:", uuid)
+
+	// 🔎 Step 2: Pastikan time slot ada
+	timeData, err := t.repository.GetTime().FindByUUID(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	// 🛑 Step 3: Tolak kalau masih ada jadwal yang memakai slot ini
+	// 📝 Catatan:
+	// Jadwal lama dan jadwal yang sudah di-soft delete juga dihitung, karena menghapus slot berarti
+	// menghapus histori booking beserta catatan release-nya. Foreign key time_id juga ON DELETE RESTRICT.
+	err = t.ensureNotUsed(ctx, timeData)
+	if err != nil {
+		return err
+	}
+
+	// 💾 Step 4: Hapus data
+	err = t.repository.GetTime().Delete(ctx, uuid)
+	if err != nil {
+		fmt.Printf("❌ [ERROR-TIME-SERVICE] Gagal menghapus data waktu: %v\n", err)
+		return err
+	}
+
+	fmt.Println("🏁 [INFO-TIME-SERVICE] Selesai Delete")
+	return nil
+}
+
+func (t *TimeService) toResponse(timeData *models.Time) dto.TimeResponse {
+	durationMinutes, _ := t.durationMinutes(timeData.StartTime, timeData.EndTime)
+	var fieldID *uuid.UUID
+	if timeData.Field != nil {
+		fieldID = &timeData.Field.UUID
+	}
+
+	return dto.TimeResponse{
+		UUID:            timeData.UUID,
+		FieldID:         fieldID,
+		StartTime:       timeData.StartTime,
+		EndTime:         timeData.EndTime,
+		DurationMinutes: durationMinutes,
+		CreatedAt:       timeData.CreatedAt,
+		UpdatedAt:       timeData.UpdatedAt,
+	}
+}
+
+// ensureNotUsed mengembalikan ErrTimeInUse kalau ada jadwal (semua tanggal, termasuk yang sudah
+// di-soft delete) yang memakai time slot ini.
+func (t *TimeService) ensureNotUsed(ctx context.Context, timeData *models.Time) error {
+	total, err := t.repository.GetFieldSchedule().CountByTimeID(ctx, timeData.ID)
+	if err != nil {
+		return err
+	}
+
+	if total > 0 {
+		fmt.Printf("⚠️ [WARN-TIME-SERVICE] Time slot %s masih dipakai %d jadwal\n", timeData.UUID, total)
+		return errTime.ErrTimeInUse
+	}
+	return nil
+}
+
+// validateTimeRange mem-parse jam mulai/selesai, memastikan durasinya didukung dan tidak
+// overlap dengan slot lain di katalog fieldID (nil = global, kecuali excludeUUID). Jam dikembalikan dalam format HH:MM:SS.
+func (t *TimeService) validateTimeRange(
+	ctx context.Context,
+	fieldID *uint,
+	req *dto.TimeRequest,
+	excludeUUID string,
+) (string, string, error) {
+	startTime, err := t.parseClock(req.StartTime)
+	if err != nil {
+		return "", "", err
+	}
+
+	endTime, err := t.parseClock(req.EndTime)
+	if err != nil {
+		return "", "", err
+	}
+
+	durationMinutes, err := t.durationMinutes(startTime, endTime)
+	if err != nil {
+		return "", "", err
+	}
+
+	if !slices.Contains(constants.TimeSlotDurations, durationMinutes) {
+		return "", "", errTime.ErrTimeInvalidDuration
+	}
+
+	overlapping, err := t.repository.GetTime().FindOverlapping(ctx, fieldID, startTime, endTime, excludeUUID)
+	if err != nil {
+		return "", "", err
+	}
+
+	if len(overlapping) > 0 {
+		fmt.Printf("⚠️ [WARN-TIME-SERVICE] Overlap dengan time slot: %+v\n", overlapping)
+		return "", "", errTime.ErrTimeOverlap
+	}
+
+	return startTime, endTime, nil
+}
+
+// durationMinutes menghitung durasi slot, slot terbalik (selesai <= mulai) ditolak.
+// Jam selesai 00:00 dibaca sebagai tengah malam (24:00), sama seperti slotMinutes di field schedule.
+func (t *TimeService) durationMinutes(startTime, endTime string) (int, error) {
+	start, err := time.Parse(time.TimeOnly, startTime)
+	if err != nil {
+		return 0, errTime.ErrTimeInvalidFormat
+	}
+
+	end, err := time.Parse(time.TimeOnly, endTime)
+	if err != nil {
+		return 0, errTime.ErrTimeInvalidFormat
+	}
+
+	if endTime == constants.MidnightTime {
+		end = end.Add(24 * time.Hour)
+	}
+
+	if !end.After(start) {
+		return 0, errTime.ErrTimeInvalidRange
+	}
+	return int(end.Sub(start).Minutes()), nil
+}
+
+// parseClock menormalkan "HH:MM" atau "HH:MM:SS" jadi "HH:MM:SS" (format kolom time di Postgres).
+func (t *TimeService) parseClock(value string) (string, error) {
+	for _, layout := range []string{time.TimeOnly, "15:04"} {
+		parsed, err := time.Parse(layout, value)
+		if err == nil {
+			return parsed.Format(time.TimeOnly), nil
+		}
+	}
+	return "", errTime.ErrTimeInvalidFormat
+}
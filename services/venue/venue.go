@@ -0,0 +1,205 @@
+package services
+
+import (
+	"context"
+	"field-service/constants"
+	errVenue "field-service/constants/error/venue"
+	"field-service/domain/dto"
+	"field-service/domain/models"
+	"field-service/repositories"
+	"fmt"
+	"time"
+)
+
+type VenueService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+type IVenueService interface {
+	GetAll(context.Context) ([]dto.VenueResponse, error)
+	GetByUUID(context.Context, string) (*dto.VenueResponse, error)
+	Create(context.Context, *dto.VenueRequest) (*dto.VenueResponse, error)
+	Update(context.Context, string, *dto.VenueRequest) (*dto.VenueResponse, error)
+	Delete(context.Context, string) error
+}
+
+func NewVenueService(repository repositories.IRepositoryRegistry) IVenueService {
+	return &VenueService{repository: repository}
+}
+
+func (v *VenueService) toResponse(venue *models.Venue) dto.VenueResponse {
+	return dto.VenueResponse{
+		UUID:         venue.UUID,
+		Name:         venue.Name,
+		Address:      venue.Address,
+		Latitude:     venue.Latitude,
+		Longitude:    venue.Longitude,
+		Timezone:     venue.Timezone,
+		OpenTime:     venue.OpenTime,
+		CloseTime:    venue.CloseTime,
+		ContactPhone: venue.ContactPhone,
+		ContactEmail: venue.ContactEmail,
+		CreatedAt:    venue.CreatedAt,
+		UpdatedAt:    venue.UpdatedAt,
+	}
+}
+
+// toModel memvalidasi timezone dan jam buka lalu mengubah request ke models.Venue.
+func (v *VenueService) toModel(request *dto.VenueRequest) (*models.Venue, error) {
+	timezone := request.Timezone
+	if timezone == "" {
+		timezone = constants.DefaultTimezone
+	}
+
+	_, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, errVenue.ErrVenueInvalidTimezone
+	}
+
+	openTime, err := v.parseClock(request.OpenTime)
+	if err != nil {
+		return nil, err
+	}
+
+	closeTime, err := v.parseClock(request.CloseTime)
+	if err != nil {
+		return nil, err
+	}
+
+	if closeTime <= openTime {
+		return nil, errVenue.ErrVenueInvalidOpeningHours
+	}
+
+	return &models.Venue{
+		Name:         request.Name,
+		Address:      request.Address,
+		Latitude:     request.Latitude,
+		Longitude:    request.Longitude,
+		Timezone:     timezone,
+		OpenTime:     openTime,
+		CloseTime:    closeTime,
+		ContactPhone: request.ContactPhone,
+		ContactEmail: request.ContactEmail,
+	}, nil
+}
+
+// parseClock menormalkan "HH:MM" atau "HH:MM:SS" jadi "HH:MM:SS" (format kolom time di Postgres).
+func (v *VenueService) parseClock(value string) (string, error) {
+	for _, layout := range []string{time.TimeOnly, "15:04"} {
+		parsed, err := time.Parse(layout, value)
+		if err == nil {
+			return parsed.Format(time.TimeOnly), nil
+		}
+	}
+	return "", errVenue.ErrVenueInvalidOpeningHours
+}
+
+func (v *VenueService) GetAll(ctx context.Context) ([]dto.VenueResponse, error) {
+	fmt.Println("🚀 [DEBUG-VENUE-SERVICE] Mulai GetAll")
+	venues, err := v.repository.GetVenue().FindAll(ctx)
+	if err != nil {
+		fmt.Println("❌ [ERROR-VENUE-SERVICE] Gagal mengambil data venue:", err)
+		return nil, err
+	}
+
+	venueResults := make([]dto.VenueResponse, 0, len(venues))
+	for _, venue := range venues {
+		venueResults = append(venueResults, v.toResponse(&venue))
+	}
+
+	fmt.Println("🏁 [INFO-VENUE-SERVICE] GetAll selesai:", len(venueResults))
+	return venueResults, nil
+}
+
+func (v *VenueService) GetByUUID(ctx context.Context, uuid string) (*dto.VenueResponse, error) {
+	fmt.Println("🔍 [DEBUG-VENUE-SERVICE] GetByUUID:", uuid)
+	venue, err := v.repository.GetVenue().FindByUUID(ctx, uuid)
+	if err != nil {
+		fmt.Println("❌ [ERROR-VENUE-SERVICE] Gagal mengambil data venue:", err)
+		return nil, err
+	}
+
+	response := v.toResponse(venue)
+	return &response, nil
+}
+
+func (v *VenueService) Create(ctx context.Context, request *dto.VenueRequest) (*dto.VenueResponse, error) {
+	// 🚀 Step 1: Mulai proses & debug input
+	fmt.Printf("🚀 [DEBUG-VENUE-SERVICE] Create: %+v\n", request)
+
+	// ✅ Step 2: Validasi timezone & jam buka
+	venue, err := v.toModel(request)
+	if err != nil {
+		fmt.Println("❌ [ERROR-VENUE-SERVICE] Request tidak valid:", err)
+		return nil, err
+	}
+
+	// 💾 Step 3: Simpan ke database
+	venue, err = v.repository.GetVenue().Create(ctx, venue)
+	if err != nil {
+		return nil, err
+	}
+
+	response := v.toResponse(venue)
+	fmt.Printf("🏁 [INFO-VENUE-SERVICE] Create selesai: %+v\n", response)
+	return &response, nil
+}
+
+func (v *VenueService) Update(ctx context.Context, uuid string, request *dto.VenueRequest) (*dto.VenueResponse, error) {
+	// 🚀 Step 1: Mulai proses & debug input
+	fmt.Printf("🚀 [DEBUG-VENUE-SERVICE] Update %s: %+v\n", uuid, request)
+
+	// 🔎 Step 2: Pastikan venue ada
+	_, err := v.repository.GetVenue().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	// ✅ Step 3: Validasi timezone & jam buka
+	venue, err := v.toModel(request)
+	if err != nil {
+		fmt.Println("❌ [ERROR-VENUE-SERVICE] Request tidak valid:", err)
+		return nil, err
+	}
+
+	// 💾 Step 4: Simpan perubahan
+	venue, err = v.repository.GetVenue().Update(ctx, uuid, venue)
+	if err != nil {
+		return nil, err
+	}
+
+	response := v.toResponse(venue)
+	fmt.Printf("🏁 [INFO-VENUE-SERVICE] Update selesai: %+v\n", response)
+	return &response, nil
+}
+
+func (v *VenueService) Delete(ctx context.Context, uuid string) error {
+	fmt.Println("🚀 [DEBUG-VENUE-SERVICE] Delete:", uuid)
+
+	// 1️⃣ Cek venue ada atau tidak
+	venue, err := v.repository.GetVenue().FindByUUID(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	// 2️⃣ Venue yang masih punya field tidak boleh dihapus
+	total, err := v.repository.GetVenue().CountFields(ctx, venue.ID)
+	if err != nil {
+		return err
+	}
+
+	if total > 0 {
+		fmt.Printf("⚠️ [WARN-VENUE-SERVICE] Venue %s masih punya %d field\n", uuid, total)
+		return errVenue.ErrVenueHasFields
+	}
+
+	// 3️⃣ Hapus venue
+	err = v.repository.GetVenue().Delete(ctx, uuid)
+	if err != nil {
+		fmt.Println("❌ [ERROR-VENUE-SERVICE] Gagal menghapus venue:", err)
+		return err
+	}
+
+	fmt.Println("🏁 [INFO-VENUE-SERVICE] Delete selesai")
+	return nil
+}
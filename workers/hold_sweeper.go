@@ -0,0 +1,45 @@
+package workers
+
+import (
+	"context"
+	"field-service/services"
+	"fmt"
+	"time"
+)
+
+type HoldSweeper struct {
+	service  services.IServiceRegistry
+	interval time.Duration
+}
+
+type IHoldSweeper interface {
+	Start(context.Context)
+}
+
+func NewHoldSweeper(service services.IServiceRegistry, interval time.Duration) IHoldSweeper {
+	return &HoldSweeper{
+		service:  service,
+		interval: interval,
+	}
+}
+
+// Start menjalankan sweeper secara berkala sampai ctx dibatalkan.
+// Setiap tick, semua slot Held yang held_until-nya sudah lewat dikembalikan ke Available.
+func (h *HoldSweeper) Start(ctx context.Context) {
+	fmt.Println("🧹 [HOLD-SWEEPER] Mulai dengan interval:", h.interval)
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			fmt.Println("🛑 [HOLD-SWEEPER] Berhenti:", ctx.Err())
+			return
+		case <-ticker.C:
+			_, err := h.service.GetFieldSchedule().ReleaseExpiredHolds(ctx)
+			if err != nil {
+				fmt.Println("❌ [HOLD-SWEEPER] Gagal release hold yang expired:", err)
+			}
+		}
+	}
+}
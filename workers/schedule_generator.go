@@ -0,0 +1,95 @@
+package workers
+
+import (
+	"context"
+	"field-service/common/util"
+	"field-service/constants"
+	"field-service/services"
+	"fmt"
+	"time"
+)
+
+type ScheduleGenerator struct {
+	service   services.IServiceRegistry
+	daysAhead int
+	hour      int
+}
+
+type IScheduleGenerator interface {
+	Start(context.Context)
+}
+
+func NewScheduleGenerator(service services.IServiceRegistry, daysAhead int, hour int) IScheduleGenerator {
+	return &ScheduleGenerator{
+		service:   service,
+		daysAhead: daysAhead,
+		hour:      hour,
+	}
+}
+
+// Start menjalankan generate schedule otomatis setiap hari pada jam yang dikonfigurasi
+// (jam lokal di timezone venue; dengan beberapa timezone, job jalan saat jam itu tiba di salah satunya)
+// sampai ctx dibatalkan.
+func (s *ScheduleGenerator) Start(ctx context.Context) {
+	for {
+		next := s.nextRun(time.Now(), s.locations(ctx))
+		fmt.Println("📆 [SCHEDULE-GENERATOR] Jadwal berikutnya:", next.Format(time.DateTime), next.Location())
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			fmt.Println("🛑 [SCHEDULE-GENERATOR] Berhenti:", ctx.Err())
+			return
+		case <-timer.C:
+			report, err := s.service.GetFieldSchedule().GenerateRolling(ctx, s.daysAhead)
+			if err != nil {
+				fmt.Println("❌ [SCHEDULE-GENERATOR] Gagal generate schedule:", err)
+				continue
+			}
+			fmt.Printf("✅ [SCHEDULE-GENERATOR] Selesai: %+v\n", report)
+		}
+	}
+}
+
+// locations mengembalikan timezone semua venue. Kalau belum ada venue atau gagal dibaca,
+// jatuh ke constants.DefaultTimezone.
+func (s *ScheduleGenerator) locations(ctx context.Context) []*time.Location {
+	venues, err := s.service.GetVenue().GetAll(ctx)
+	if err != nil {
+		fmt.Println("⚠️ [SCHEDULE-GENERATOR] Gagal ambil venue, pakai timezone default:", err)
+	}
+
+	locations := make([]*time.Location, 0, len(venues))
+	seen := make(map[string]bool, len(venues))
+	for _, venue := range venues {
+		location := util.LoadLocation(venue.Timezone)
+		if seen[location.String()] {
+			continue
+		}
+		seen[location.String()] = true
+		locations = append(locations, location)
+	}
+
+	if len(locations) == 0 {
+		locations = append(locations, util.LoadLocation(constants.DefaultTimezone))
+	}
+	return locations
+}
+
+// nextRun menghitung waktu jalan berikutnya: jam s.hour paling dekat di antara semua locations
+// (hari ini jika jamnya belum lewat, kalau sudah besok).
+func (s *ScheduleGenerator) nextRun(now time.Time, locations []*time.Location) time.Time {
+	var earliest time.Time
+	for _, location := range locations {
+		local := now.In(location)
+		next := time.Date(local.Year(), local.Month(), local.Day(), s.hour, 0, 0, 0, location)
+		if !next.After(now) {
+			next = next.AddDate(0, 0, 1)
+		}
+		if earliest.IsZero() || next.Before(earliest) {
+			earliest = next
+		}
+	}
+	return earliest
+}